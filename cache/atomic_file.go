@@ -0,0 +1,29 @@
+package cache
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+)
+
+//writeFileAtomic writes to a temporary file first, so a crash never leaves a half-written file
+func writeFileAtomic(filePath string, content []byte) error {
+	var dir = filepath.Dir(filePath)
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return err
+	}
+	var tmp, err = ioutil.TempFile(dir, filepath.Base(filePath)+".*")
+	if err != nil {
+		return err
+	}
+	if _, err = tmp.Write(content); err != nil {
+		_ = tmp.Close()
+		_ = os.Remove(tmp.Name())
+		return err
+	}
+	if err = tmp.Close(); err != nil {
+		_ = os.Remove(tmp.Name())
+		return err
+	}
+	return os.Rename(tmp.Name(), filePath)
+}
@@ -0,0 +1,131 @@
+package cache
+
+import (
+	"bitbucket.org/y4cxp543/telegram-bot/constants"
+	"bitbucket.org/y4cxp543/telegram-bot/interfaces"
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"strconv"
+	"sync"
+)
+
+//NewConversationStore returns file-backed store if filePath is set, in-memory store otherwise
+func NewConversationStore(filePath string) interfaces.ConversationStore {
+	if filePath == constants.EmptyString {
+		return new(MemoryConversationStore)
+	}
+	return NewFileConversationStore(filePath)
+}
+
+func conversationKey(chatId, userId int64) string {
+	return strconv.FormatInt(chatId, 10) + constants.Space + strconv.FormatInt(userId, 10)
+}
+
+//MemoryConversationStore keeps dialogs until the process exits
+type MemoryConversationStore struct {
+	mutex  sync.Mutex
+	states map[string]interfaces.ConversationState
+}
+
+func (store *MemoryConversationStore) LoadAll() ([]interfaces.ConversationState, error) {
+	store.mutex.Lock()
+	defer store.mutex.Unlock()
+	var states = make([]interfaces.ConversationState, 0, len(store.states))
+	for _, state := range store.states {
+		states = append(states, state)
+	}
+	return states, nil
+}
+
+func (store *MemoryConversationStore) Save(state interfaces.ConversationState) error {
+	store.mutex.Lock()
+	defer store.mutex.Unlock()
+	if store.states == nil {
+		store.states = make(map[string]interfaces.ConversationState)
+	}
+	store.states[conversationKey(state.ChatId, state.UserId)] = state
+	return nil
+}
+
+func (store *MemoryConversationStore) Delete(chatId, userId int64) error {
+	store.mutex.Lock()
+	defer store.mutex.Unlock()
+	delete(store.states, conversationKey(chatId, userId))
+	return nil
+}
+
+//FileConversationStore keeps dialogs as a JSON array in the file, rewritten on every change
+type FileConversationStore struct {
+	memory   MemoryConversationStore
+	mutex    sync.Mutex
+	filePath string
+	loaded   bool
+}
+
+func NewFileConversationStore(filePath string) *FileConversationStore {
+	return &FileConversationStore{filePath: filePath}
+}
+
+func (store *FileConversationStore) LoadAll() ([]interfaces.ConversationState, error) {
+	store.mutex.Lock()
+	defer store.mutex.Unlock()
+	if err := store.load(); err != nil {
+		return nil, err
+	}
+	return store.memory.LoadAll()
+}
+
+func (store *FileConversationStore) Save(state interfaces.ConversationState) error {
+	store.mutex.Lock()
+	defer store.mutex.Unlock()
+	if err := store.load(); err != nil {
+		return err
+	}
+	_ = store.memory.Save(state)
+	return store.save()
+}
+
+func (store *FileConversationStore) Delete(chatId, userId int64) error {
+	store.mutex.Lock()
+	defer store.mutex.Unlock()
+	if err := store.load(); err != nil {
+		return err
+	}
+	_ = store.memory.Delete(chatId, userId)
+	return store.save()
+}
+
+func (store *FileConversationStore) load() error {
+	if store.loaded {
+		return nil
+	}
+	var byteArr, err = ioutil.ReadFile(store.filePath)
+	if os.IsNotExist(err) {
+		store.loaded = true
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	var states []interfaces.ConversationState
+	if len(byteArr) > 0 {
+		if err = json.Unmarshal(byteArr, &states); err != nil {
+			return err
+		}
+	}
+	for _, state := range states {
+		_ = store.memory.Save(state)
+	}
+	store.loaded = true
+	return nil
+}
+
+func (store *FileConversationStore) save() error {
+	var states, _ = store.memory.LoadAll()
+	var byteArr, err = json.Marshal(states)
+	if err != nil {
+		return err
+	}
+	return writeFileAtomic(store.filePath, byteArr)
+}
@@ -0,0 +1,103 @@
+package cache
+
+import (
+	"bitbucket.org/y4cxp543/telegram-bot/constants"
+	"bitbucket.org/y4cxp543/telegram-bot/interfaces"
+	"io/ioutil"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+//NewOffsetStore returns file-backed store if filePath is set, in-memory store otherwise
+func NewOffsetStore(filePath string) interfaces.OffsetStore {
+	if filePath == constants.EmptyString {
+		return new(MemoryOffsetStore)
+	}
+	return NewFileOffsetStore(filePath)
+}
+
+//MemoryOffsetStore keeps offset until the process exits
+type MemoryOffsetStore struct {
+	mutex        sync.Mutex
+	lastUpdateId int
+}
+
+func (store *MemoryOffsetStore) LastUpdateId() (int, error) {
+	store.mutex.Lock()
+	defer store.mutex.Unlock()
+	return store.lastUpdateId, nil
+}
+
+func (store *MemoryOffsetStore) Commit(updateId int) error {
+	store.mutex.Lock()
+	defer store.mutex.Unlock()
+	if updateId > store.lastUpdateId {
+		store.lastUpdateId = updateId
+	}
+	return nil
+}
+
+//FileOffsetStore keeps offset as a plain number in the file
+type FileOffsetStore struct {
+	mutex        sync.Mutex
+	filePath     string
+	lastUpdateId int
+	loaded       bool
+}
+
+func NewFileOffsetStore(filePath string) *FileOffsetStore {
+	return &FileOffsetStore{filePath: filePath}
+}
+
+func (store *FileOffsetStore) LastUpdateId() (int, error) {
+	store.mutex.Lock()
+	defer store.mutex.Unlock()
+	if err := store.load(); err != nil {
+		return 0, err
+	}
+	return store.lastUpdateId, nil
+}
+
+func (store *FileOffsetStore) Commit(updateId int) error {
+	store.mutex.Lock()
+	defer store.mutex.Unlock()
+	if err := store.load(); err != nil {
+		return err
+	}
+	if updateId <= store.lastUpdateId {
+		return nil
+	}
+	if err := store.save(updateId); err != nil {
+		return err
+	}
+	store.lastUpdateId = updateId
+	return nil
+}
+
+func (store *FileOffsetStore) load() error {
+	if store.loaded {
+		return nil
+	}
+	var byteArr, err = ioutil.ReadFile(store.filePath)
+	if os.IsNotExist(err) {
+		store.loaded = true
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	var content = strings.TrimSpace(string(byteArr))
+	if content != constants.EmptyString {
+		if store.lastUpdateId, err = strconv.Atoi(content); err != nil {
+			return err
+		}
+	}
+	store.loaded = true
+	return nil
+}
+
+func (store *FileOffsetStore) save(updateId int) error {
+	return writeFileAtomic(store.filePath, []byte(strconv.Itoa(updateId)))
+}
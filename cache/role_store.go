@@ -0,0 +1,123 @@
+package cache
+
+import (
+	"bitbucket.org/y4cxp543/telegram-bot/constants"
+	"bitbucket.org/y4cxp543/telegram-bot/interfaces"
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"strconv"
+	"sync"
+)
+
+//NewRoleStore returns file-backed store if filePath is set, in-memory store otherwise
+func NewRoleStore(filePath string) interfaces.RoleStore {
+	if filePath == constants.EmptyString {
+		return new(MemoryRoleStore)
+	}
+	return NewFileRoleStore(filePath)
+}
+
+//MemoryRoleStore keeps granted roles until the process exits
+type MemoryRoleStore struct {
+	mutex sync.Mutex
+	roles map[int64]constants.Role
+}
+
+func (store *MemoryRoleStore) LoadAll() (map[int64]constants.Role, error) {
+	store.mutex.Lock()
+	defer store.mutex.Unlock()
+	var roles = make(map[int64]constants.Role, len(store.roles))
+	for userId, role := range store.roles {
+		roles[userId] = role
+	}
+	return roles, nil
+}
+
+//Save grants the role, empty role revokes it
+func (store *MemoryRoleStore) Save(userId int64, role constants.Role) error {
+	store.mutex.Lock()
+	defer store.mutex.Unlock()
+	if role == "" {
+		delete(store.roles, userId)
+		return nil
+	}
+	if store.roles == nil {
+		store.roles = make(map[int64]constants.Role)
+	}
+	store.roles[userId] = role
+	return nil
+}
+
+//FileRoleStore keeps granted roles as a JSON object of user id to role, rewritten on every change
+type FileRoleStore struct {
+	memory   MemoryRoleStore
+	mutex    sync.Mutex
+	filePath string
+	loaded   bool
+}
+
+func NewFileRoleStore(filePath string) *FileRoleStore {
+	return &FileRoleStore{filePath: filePath}
+}
+
+func (store *FileRoleStore) LoadAll() (map[int64]constants.Role, error) {
+	store.mutex.Lock()
+	defer store.mutex.Unlock()
+	if err := store.load(); err != nil {
+		return nil, err
+	}
+	return store.memory.LoadAll()
+}
+
+func (store *FileRoleStore) Save(userId int64, role constants.Role) error {
+	store.mutex.Lock()
+	defer store.mutex.Unlock()
+	if err := store.load(); err != nil {
+		return err
+	}
+	_ = store.memory.Save(userId, role)
+	return store.save()
+}
+
+func (store *FileRoleStore) load() error {
+	if store.loaded {
+		return nil
+	}
+	var byteArr, err = ioutil.ReadFile(store.filePath)
+	if os.IsNotExist(err) {
+		store.loaded = true
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	var roles map[string]constants.Role
+	if len(byteArr) > 0 {
+		if err = json.Unmarshal(byteArr, &roles); err != nil {
+			return err
+		}
+	}
+	for key, role := range roles {
+		var userId, err = strconv.ParseInt(key, 10, 64)
+		if err != nil {
+			return err
+		}
+		_ = store.memory.Save(userId, role)
+	}
+	store.loaded = true
+	return nil
+}
+
+func (store *FileRoleStore) save() error {
+	var roles, _ = store.memory.LoadAll()
+	var byKey = make(map[string]constants.Role, len(roles))
+	for userId, role := range roles {
+		byKey[strconv.FormatInt(userId, 10)] = role
+	}
+	var byteArr, err = json.Marshal(byKey)
+	if err != nil {
+		return err
+	}
+	return writeFileAtomic(store.filePath, byteArr)
+}
@@ -0,0 +1,66 @@
+package cache
+
+import (
+	"bitbucket.org/y4cxp543/telegram-bot/constants"
+	"bitbucket.org/y4cxp543/telegram-bot/interfaces"
+	"io/ioutil"
+	"os"
+	"strings"
+	"sync"
+)
+
+//NewServerStore returns file-backed store if filePath is set, in-memory store otherwise
+func NewServerStore(filePath string) interfaces.ServerStore {
+	if filePath == constants.EmptyString {
+		return new(MemoryServerStore)
+	}
+	return NewFileServerStore(filePath)
+}
+
+//MemoryServerStore keeps the server until the process exits
+type MemoryServerStore struct {
+	mutex  sync.Mutex
+	server string
+}
+
+func (store *MemoryServerStore) LastServer() (string, error) {
+	store.mutex.Lock()
+	defer store.mutex.Unlock()
+	return store.server, nil
+}
+
+func (store *MemoryServerStore) Save(server string) error {
+	store.mutex.Lock()
+	defer store.mutex.Unlock()
+	store.server = server
+	return nil
+}
+
+//FileServerStore keeps url of the server as plain text in the file
+type FileServerStore struct {
+	mutex    sync.Mutex
+	filePath string
+}
+
+func NewFileServerStore(filePath string) *FileServerStore {
+	return &FileServerStore{filePath: filePath}
+}
+
+func (store *FileServerStore) LastServer() (string, error) {
+	store.mutex.Lock()
+	defer store.mutex.Unlock()
+	var byteArr, err = ioutil.ReadFile(store.filePath)
+	if os.IsNotExist(err) {
+		return constants.EmptyString, nil
+	}
+	if err != nil {
+		return constants.EmptyString, err
+	}
+	return strings.TrimSpace(string(byteArr)), nil
+}
+
+func (store *FileServerStore) Save(server string) error {
+	store.mutex.Lock()
+	defer store.mutex.Unlock()
+	return writeFileAtomic(store.filePath, []byte(server))
+}
@@ -1,10 +1,15 @@
 package cache
 
+import "sync"
+
 type TemporaryCache struct {
+	mutex   sync.Mutex
 	wrapper map[string]interface{}
 }
 
 func (cache *TemporaryCache) Put(key string, value interface{}) {
+	cache.mutex.Lock()
+	defer cache.mutex.Unlock()
 	if cache.wrapper == nil {
 		cache.wrapper = make(map[string]interface{})
 	}
@@ -12,6 +17,8 @@ func (cache *TemporaryCache) Put(key string, value interface{}) {
 }
 
 func (cache *TemporaryCache) Get(key string) interface{} {
+	cache.mutex.Lock()
+	defer cache.mutex.Unlock()
 	var temporary = cache.wrapper[key]
 	if temporary != nil {
 		delete(cache.wrapper, key)
@@ -3,15 +3,23 @@ package main
 import (
 	"bitbucket.org/y4cxp543/telegram-bot/constants"
 	"bitbucket.org/y4cxp543/telegram-bot/util"
+	"bytes"
+	"flag"
+	"fmt"
 	"github.com/PuerkitoBio/goquery"
+	"go/format"
+	"io/ioutil"
 	"log"
 	"net/http"
 	"os"
 	"path"
 	"reflect"
+	"regexp"
 	"runtime"
 	"strings"
 	"text/template"
+	"unicode"
+	"unicode/utf8"
 )
 
 const TelegramModelsUrl = "https://core.telegram.org/bots/api"
@@ -26,51 +34,476 @@ type StructureField struct {
 	IsOptional                                    bool
 }
 
+//MethodDescriptor Bot API method. RequestType is empty for methods without parameters
+type MethodDescriptor struct {
+	Name, ApiName, Description, RequestType, ReturnType string
+}
+
+//ClientDescriptor data for client templates
+type ClientDescriptor struct {
+	Methods        []MethodDescriptor
+	Unions         []UnionDescriptor
+	Containers     []StructureDescriptor
+	UsesRawMessage bool
+	UsesDecoders   bool
+}
+
+//UnionDescriptor Bot API type which is one of the listed objects.
+//Discriminator is json name of the field with variant value, DiscriminatorField is its go name.
+//Unions without discriminator tell variants apart by the Key fields
+type UnionDescriptor struct {
+	Name, Description, Discriminator, DiscriminatorField string
+	Variants                                             []UnionVariant
+}
+
+//UnionVariant Key is checked along with Value when several variants share it
+type UnionVariant struct {
+	Name, Value, Key string
+}
+
+//unions documentation describes in prose, so they are listed by hand. Order matters for variants with Key
+var unions = []UnionDescriptor{
+	{
+		Name:               "InlineQueryResult",
+		Description:        "This object represents one result of an inline query.",
+		Discriminator:      "type",
+		DiscriminatorField: "Type",
+		Variants: []UnionVariant{
+			{Name: "InlineQueryResultCachedAudio", Value: "audio", Key: "audio_file_id"},
+			{Name: "InlineQueryResultCachedDocument", Value: "document", Key: "document_file_id"},
+			{Name: "InlineQueryResultCachedGif", Value: "gif", Key: "gif_file_id"},
+			{Name: "InlineQueryResultCachedMpeg4Gif", Value: "mpeg4_gif", Key: "mpeg4_file_id"},
+			{Name: "InlineQueryResultCachedPhoto", Value: "photo", Key: "photo_file_id"},
+			{Name: "InlineQueryResultCachedSticker", Value: "sticker"},
+			{Name: "InlineQueryResultCachedVideo", Value: "video", Key: "video_file_id"},
+			{Name: "InlineQueryResultCachedVoice", Value: "voice", Key: "voice_file_id"},
+			{Name: "InlineQueryResultArticle", Value: "article"},
+			{Name: "InlineQueryResultAudio", Value: "audio"},
+			{Name: "InlineQueryResultContact", Value: "contact"},
+			{Name: "InlineQueryResultGame", Value: "game"},
+			{Name: "InlineQueryResultDocument", Value: "document"},
+			{Name: "InlineQueryResultGif", Value: "gif"},
+			{Name: "InlineQueryResultLocation", Value: "location"},
+			{Name: "InlineQueryResultMpeg4Gif", Value: "mpeg4_gif"},
+			{Name: "InlineQueryResultPhoto", Value: "photo"},
+			{Name: "InlineQueryResultVenue", Value: "venue"},
+			{Name: "InlineQueryResultVideo", Value: "video"},
+			{Name: "InlineQueryResultVoice", Value: "voice"},
+		},
+	},
+	{
+		Name:               "InputMedia",
+		Description:        "This object represents the content of a media message to be sent.",
+		Discriminator:      "type",
+		DiscriminatorField: "Type",
+		Variants: []UnionVariant{
+			{Name: "InputMediaAnimation", Value: "animation"},
+			{Name: "InputMediaDocument", Value: "document"},
+			{Name: "InputMediaAudio", Value: "audio"},
+			{Name: "InputMediaPhoto", Value: "photo"},
+			{Name: "InputMediaVideo", Value: "video"},
+		},
+	},
+	{
+		Name:        "InputMessageContent",
+		Description: "This object represents the content of a message to be sent as a result of an inline query.",
+		Variants: []UnionVariant{
+			{Name: "InputTextMessageContent", Key: "message_text"},
+			{Name: "InputVenueMessageContent", Key: "address"},
+			{Name: "InputLocationMessageContent", Key: "latitude"},
+			{Name: "InputContactMessageContent", Key: "phone_number"},
+			{Name: "InputInvoiceMessageContent", Key: "payload"},
+		},
+	},
+	{
+		Name:               "ChatMember",
+		Description:        "This object contains information about one member of a chat.",
+		Discriminator:      "status",
+		DiscriminatorField: "Status",
+		Variants: []UnionVariant{
+			{Name: "ChatMemberOwner", Value: "creator"},
+			{Name: "ChatMemberAdministrator", Value: "administrator"},
+			{Name: "ChatMemberMember", Value: "member"},
+			{Name: "ChatMemberRestricted", Value: "restricted"},
+			{Name: "ChatMemberLeft", Value: "left"},
+			{Name: "ChatMemberBanned", Value: "kicked"},
+		},
+	},
+	{
+		Name:               "BotCommandScope",
+		Description:        "This object represents the scope to which bot commands are applied.",
+		Discriminator:      "type",
+		DiscriminatorField: "Type",
+		Variants: []UnionVariant{
+			{Name: "BotCommandScopeDefault", Value: "default"},
+			{Name: "BotCommandScopeAllPrivateChats", Value: "all_private_chats"},
+			{Name: "BotCommandScopeAllGroupChats", Value: "all_group_chats"},
+			{Name: "BotCommandScopeAllChatAdministrators", Value: "all_chat_administrators"},
+			{Name: "BotCommandScopeChat", Value: "chat"},
+			{Name: "BotCommandScopeChatAdministrators", Value: "chat_administrators"},
+			{Name: "BotCommandScopeChatMember", Value: "chat_member"},
+		},
+	},
+	{
+		Name:               "PassportElementError",
+		Description:        "This object represents an error in the Telegram Passport element which was submitted that should be resolved by the user.",
+		Discriminator:      "source",
+		DiscriminatorField: "Source",
+		Variants: []UnionVariant{
+			{Name: "PassportElementErrorDataField", Value: "data"},
+			{Name: "PassportElementErrorFrontSide", Value: "front_side"},
+			{Name: "PassportElementErrorReverseSide", Value: "reverse_side"},
+			{Name: "PassportElementErrorSelfie", Value: "selfie"},
+			{Name: "PassportElementErrorFile", Value: "file"},
+			{Name: "PassportElementErrorFiles", Value: "files"},
+			{Name: "PassportElementErrorTranslationFile", Value: "translation_file"},
+			{Name: "PassportElementErrorTranslationFiles", Value: "translation_files"},
+			{Name: "PassportElementErrorUnspecified", Value: "unspecified"},
+		},
+	},
+	{
+		Name:        "ReplyMarkup",
+		Description: "Additional interface options: inline keyboard, custom reply keyboard, instructions to remove reply keyboard or to force a reply from the user.",
+		Variants: []UnionVariant{
+			{Name: "InlineKeyboardMarkup", Key: "inline_keyboard"},
+			{Name: "ReplyKeyboardMarkup", Key: "keyboard"},
+			{Name: "ReplyKeyboardRemove", Key: "remove_keyboard"},
+			{Name: "ForceReply", Key: "force_reply"},
+		},
+	},
+}
+
+//unionsOutput generated union types, relative to the output directory
+const unionsOutput = "telegram/models/unions_gen.go"
+
+//handWrittenMethods are implemented in telegram package by hand, only their constants are generated
+var handWrittenMethods = map[string]bool{
+	"GetUpdates": true,
+}
+
+//modelTemplate renders structures to modelOutput, relative to the output directory
+const (
+	modelTemplate = "template.tmpl"
+	modelOutput   = "cmd/pk-update-model-v2/model.go"
+)
+
+var clientTemplates = map[string]string{
+	"client.tmpl":    "telegram/telegram_functions_gen.go",
+	"interface.tmpl": "interfaces/itelegram_api_gen.go",
+	"methods.tmpl":   "constants/telegram_methods_gen.go",
+}
+
+var returnSentenceRegex = regexp.MustCompile(`[^.]*(Returns|returns|is returned)[^.]*\.`)
+var typeWordRegex = regexp.MustCompile(`[A-Z][A-Za-z]+`)
+var listSeparatorRegex = regexp.MustCompile(`, | and | or `)
+
 func main() {
-	var response, _ = http.Get(TelegramModelsUrl)
-	var doc, error = goquery.NewDocumentFromReader(response.Body)
-	if error != nil {
-		log.Panic(error)
+	_, filePath, _, ok := runtime.Caller(0)
+	if !ok {
+		log.Fatal("No caller information")
+	}
+	var generatorDir = path.Dir(filePath)
+
+	var page = flag.String("page", "", "saved copy of "+TelegramModelsUrl+", downloaded when empty")
+	var save = flag.String("save", "", "save downloaded page to the file to regenerate offline later")
+	var output = flag.String("out", path.Join(generatorDir, "../.."), "directory generated files are written to")
+	flag.Parse()
+
+	var doc, err = loadDocument(*page, *save)
+	if err != nil {
+		log.Fatal(err)
+	}
+	var structureDescriptions, methods = parseDocument(doc)
+
+	model, err := renderTemplate(path.Join(generatorDir, modelTemplate), structureDescriptions)
+	if err != nil {
+		log.Fatal(err)
 	}
+	writeFile(path.Join(*output, modelOutput), model)
 
+	var client = createClientDescriptor(structureDescriptions, methods)
+	for templateName, outputFile := range clientTemplates {
+		generateClientFile(path.Join(generatorDir, templateName), path.Join(*output, outputFile), client)
+	}
+	generateClientFile(path.Join(generatorDir, "unions.tmpl"), path.Join(*output, unionsOutput), client)
+}
+
+//loadDocument reads the saved page or downloads it (and saves, if savePath is set)
+func loadDocument(pagePath, savePath string) (*goquery.Document, error) {
+	if pagePath != constants.EmptyString {
+		var file, err = os.Open(pagePath)
+		if err != nil {
+			return nil, err
+		}
+		defer file.Close()
+		return goquery.NewDocumentFromReader(file)
+	}
+	var response, err = http.Get(TelegramModelsUrl)
+	if err != nil {
+		return nil, err
+	}
+	defer response.Body.Close()
+	if response.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("cannot download %s, http status %d", TelegramModelsUrl, response.StatusCode)
+	}
+	body, err := ioutil.ReadAll(response.Body)
+	if err != nil {
+		return nil, err
+	}
+	if savePath != constants.EmptyString {
+		if err := ioutil.WriteFile(savePath, body, 0644); err != nil {
+			return nil, err
+		}
+		log.Println("Page saved to " + savePath)
+	}
+	return goquery.NewDocumentFromReader(bytes.NewReader(body))
+}
+
+//parseDocument collects types and methods. Methods are h4 sections with lowercase names (sendMessage),
+//their parameter tables become request structures. Methods without parameters have no structure
+func parseDocument(doc *goquery.Document) ([]StructureDescriptor, []MethodDescriptor) {
 	var structureDescriptions = make([]StructureDescriptor, 0)
+	var methods = make([]MethodDescriptor, 0)
 	doc.Find("html body div div div div div h4").Each(func(i int, selection *goquery.Selection) {
-		var structureName = strings.Title(selection.Text())
-		if !strings.Contains(structureName, constants.Space) {
-			var h4 = selection.NextUntil("h4")
-			var structureDescription = h4.Closest("p").First().Text()
-			var colNames = h4.Find("table thead tr th")
-			var colValues = h4.Find("table tbody tr")
-			if structureDescription != constants.EmptyString &&
-				colNames.Size() > 0 &&
-				colValues.Size() > 0 {
-				var structDesc = StructureDescriptor{
-					Name:        structureName,
-					Description: structureDescription,
-					Field:       createStructFields(colNames, colValues),
-				}
-				structureDescriptions = append(structureDescriptions, structDesc)
+		var name = strings.TrimSpace(selection.Text())
+		if name == constants.EmptyString || strings.Contains(name, constants.Space) {
+			return
+		}
+		var structureName = strings.Title(name)
+		var h4 = selection.NextUntil("h4")
+		var structureDescription = h4.Closest("p").First().Text()
+		var colNames = h4.Find("table thead tr th")
+		var colValues = h4.Find("table tbody tr")
+		var hasFields = colNames.Size() > 0 && colValues.Size() > 0
+		if structureDescription != constants.EmptyString && hasFields {
+			structureDescriptions = append(structureDescriptions, StructureDescriptor{
+				Name:        structureName,
+				Description: structureDescription,
+				Field:       createStructFields(structureName, colNames, colValues),
+			})
+		} else if isPlaceholder(name, structureDescription) {
+			//CallbackGame and alike hold no information yet
+			structureDescriptions = append(structureDescriptions, StructureDescriptor{
+				Name:        structureName,
+				Description: structureDescription,
+			})
+		}
+		if isMethodName(name) {
+			var method = MethodDescriptor{
+				Name:        structureName,
+				ApiName:     name,
+				Description: structureDescription,
+			}
+			if hasFields {
+				method.RequestType = "models." + structureName
 			}
+			methods = append(methods, method)
 		}
 	})
+	return structureDescriptions, methods
+}
 
-	_, filePath, _, ok := runtime.Caller(0)
-	if !ok {
-		log.Fatal("No caller information")
+//isPlaceholder type without fields, which is neither a union nor a file
+func isPlaceholder(name, description string) bool {
+	return description != constants.EmptyString && !isMethodName(name) && name != "InputFile" && findUnion(name) == nil
+}
+
+func isMethodName(name string) bool {
+	var first, _ = utf8.DecodeRuneInString(name)
+	return unicode.IsLower(first)
+}
+
+//generateClientFile executes template and saves gofmt-ed result
+func generateClientFile(templatePath, outputPath string, client ClientDescriptor) {
+	var source, err = renderClientFile(templatePath, client)
+	if err != nil {
+		log.Fatal(err)
 	}
+	writeFile(outputPath, source)
+}
 
-	var tmpl, err = template.New("template.tmpl").ParseFiles(path.Dir(filePath) + "/template.tmpl")
+//renderClientFile executes template and formats the result with gofmt
+func renderClientFile(templatePath string, client ClientDescriptor) ([]byte, error) {
+	var source, err = renderTemplate(templatePath, client)
 	if err != nil {
+		return nil, err
+	}
+	return format.Source(source)
+}
+
+func renderTemplate(templatePath string, data interface{}) ([]byte, error) {
+	var tmpl, err = template.New(path.Base(templatePath)).ParseFiles(templatePath)
+	if err != nil {
+		return nil, err
+	}
+	var buffer = new(bytes.Buffer)
+	if err := tmpl.Execute(buffer, data); err != nil {
+		return nil, err
+	}
+	return buffer.Bytes(), nil
+}
+
+func writeFile(outputPath string, content []byte) {
+	if err := os.MkdirAll(path.Dir(outputPath), 0755); err != nil {
 		log.Fatal(err)
 	}
-	f, _ := os.Create(path.Dir(filePath) + "/model.go")
-	if err := tmpl.Execute(f, structureDescriptions); err != nil {
+	if err := ioutil.WriteFile(outputPath, content, 0644); err != nil {
 		log.Fatal(err)
 	}
-	log.Println("Alright, saved to " + filePath)
+	log.Println("Alright, saved to " + outputPath)
 }
 
-func createStructFields(names *goquery.Selection, values *goquery.Selection) []StructureField {
+//createClientDescriptor resolves return types of the methods against parsed types.
+//Constants are generated for every method, client functions only for not hand written ones
+func createClientDescriptor(structures []StructureDescriptor, methods []MethodDescriptor) ClientDescriptor {
+	var known = make(map[string]bool)
+	for _, structure := range structures {
+		known[structure.Name] = true
+	}
+	var requests = make(map[string]bool)
+	for _, method := range methods {
+		delete(known, method.Name)
+		requests[method.Name] = true
+	}
+	for _, union := range unions {
+		known[union.Name] = true
+	}
+	var client = ClientDescriptor{
+		Methods:    make([]MethodDescriptor, 0),
+		Unions:     unions,
+		Containers: make([]StructureDescriptor, 0),
+	}
+	for _, method := range methods {
+		method.ReturnType = parseReturnType(method.Description, known)
+		if !handWrittenMethods[method.Name] {
+			client.UsesRawMessage = client.UsesRawMessage || method.ReturnType == "json.RawMessage"
+			client.UsesDecoders = client.UsesDecoders || method.Decoder() != constants.EmptyString
+		}
+		client.Methods = append(client.Methods, method)
+	}
+	//Requests are only sent, decoding is needed for the received types
+	for _, structure := range structures {
+		if requests[structure.Name] {
+			continue
+		}
+		var unionFields = make([]StructureField, 0)
+		for _, field := range structure.Field {
+			if field.UnionName() != constants.EmptyString {
+				unionFields = append(unionFields, field)
+			}
+		}
+		if len(unionFields) > 0 {
+			client.Containers = append(client.Containers, StructureDescriptor{
+				Name:        structure.Name,
+				Description: structure.Description,
+				Field:       unionFields,
+			})
+		}
+	}
+	return client
+}
+
+//Decoder function decoding union return type, empty for other types
+func (method MethodDescriptor) Decoder() string {
+	var name = strings.TrimPrefix(strings.TrimPrefix(method.ReturnType, "[]"), "models.")
+	if findUnion(name) == nil {
+		return constants.EmptyString
+	}
+	if strings.HasPrefix(method.ReturnType, "[]") {
+		return "models.Unmarshal" + name + "Array"
+	}
+	return "models.Unmarshal" + name
+}
+
+//UnionName union the field holds, empty for other types
+func (field StructureField) UnionName() string {
+	var name = strings.TrimPrefix(field.TypeName, "[]")
+	if findUnion(name) == nil {
+		return constants.EmptyString
+	}
+	return name
+}
+
+//IsArray field holds array of values
+func (field StructureField) IsArray() bool {
+	return strings.HasPrefix(field.TypeName, "[]")
+}
+
+func findUnion(name string) *UnionDescriptor {
+	for i := range unions {
+		if unions[i].Name == name {
+			return &unions[i]
+		}
+	}
+	return nil
+}
+
+//unionOf finds union by its name or by the list of its variants: "InputMediaPhoto and InputMediaVideo",
+//"InlineKeyboardMarkup or ReplyKeyboardMarkup or ReplyKeyboardRemove or ForceReply"
+func unionOf(docType string) string {
+	if union := findUnion(docType); union != nil {
+		return union.Name
+	}
+	var names = listSeparatorRegex.Split(docType, -1)
+	if len(names) < 2 {
+		return constants.EmptyString
+	}
+	for _, union := range unions {
+		var variants = make(map[string]bool)
+		for _, variant := range union.Variants {
+			variants[variant.Name] = true
+		}
+		var all = true
+		for _, name := range names {
+			all = all && variants[name]
+		}
+		if all {
+			return union.Name
+		}
+	}
+	return constants.EmptyString
+}
+
+//IsHandWritten used by templates to skip methods implemented in telegram package
+func (method MethodDescriptor) IsHandWritten() bool {
+	return handWrittenMethods[method.Name]
+}
+
+//parseReturnType finds the type in "Returns ..." sentence of method description.
+//Types without descriptor are returned as json.RawMessage
+func parseReturnType(description string, known map[string]bool) string {
+	var sentence = returnSentenceRegex.FindString(description)
+	if sentence == constants.EmptyString {
+		return "json.RawMessage"
+	}
+	if strings.Contains(sentence, "otherwise True is returned") || strings.Contains(sentence, "otherwise returns True") {
+		return "models.MessageOrTrue"
+	}
+	var isArray = strings.Contains(strings.ToLower(sentence), "array of")
+	for _, word := range typeWordRegex.FindAllString(sentence, -1) {
+		switch word {
+		case "True":
+			return "bool"
+		case "Int":
+			return "int"
+		case "String":
+			return "string"
+		}
+		var name = word
+		if !known[name] && known[strings.TrimSuffix(name, "s")] {
+			name = strings.TrimSuffix(name, "s")
+		}
+		if known[name] {
+			if isArray {
+				return "[]models." + name
+			}
+			return "models." + name
+		}
+	}
+	return "json.RawMessage"
+}
+
+func createStructFields(structureName string, names *goquery.Selection, values *goquery.Selection) []StructureField {
 	var structureFields = make([]StructureField, values.Size())
 	values.Each(func(i int, selection *goquery.Selection) {
 		var structureField = StructureField{}
@@ -83,10 +516,12 @@ func createStructFields(names *goquery.Selection, values *goquery.Selection) []S
 				structureField.Name = util.Capitalize(structureField.SerializableName)
 			case "Type":
 				var documentationType = selection.Text()
-				if goType := commonTypeToGoType(documentationType); goType != reflect.Invalid {
+				if documentationType == chatIdDocType {
+					structureField.TypeName = "ChatID"
+				} else if goType := commonTypeToGoType(documentationType); goType != reflect.Invalid {
 					structureField.TypeName = goType.String()
 				} else {
-					structureField.TypeName = tryHard(documentationType, &structureFields)
+					structureField.TypeName = tryHard(documentationType)
 				}
 			case "Description":
 				structureField.Description = selection.Text()
@@ -101,11 +536,61 @@ func createStructFields(names *goquery.Selection, values *goquery.Selection) []S
 				}
 			}
 		})
+		structureField.TypeName = refineType(structureName, structureField)
 		structureFields[i] = structureField
 	})
 	return structureFields
 }
 
+//chatIdDocType chat_id parameters taking id or @channelusername
+const chatIdDocType = "Integer or String"
+
+//identifierFields may not fit 32 bits, though their description does not tell so
+var identifierFields = map[string]bool{
+	"chat_id":              true,
+	"from_chat_id":         true,
+	"user_id":              true,
+	"migrate_to_chat_id":   true,
+	"migrate_from_chat_id": true,
+}
+
+//identifierTypes ids of these types may not fit 32 bits. Older documentation does not tell so for users
+//and tells "may be greater than 32 bits" for chats
+var identifierTypes = map[string]bool{
+	"User": true,
+	"Chat": true,
+}
+
+//bitsRegex descriptions of numbers which may not fit 32 bits
+var bitsRegex = regexp.MustCompile(`32 (significant )?bits`)
+
+//optionalPointerTypes zero values of them are meaningful, so optional ones are sent only when set
+var optionalPointerTypes = map[string]bool{
+	"bool":      true,
+	"int":       true,
+	"int64":     true,
+	"float32":   true,
+	"ChatID":    true,
+	"InputFile": true,
+}
+
+//refineType applies what the documentation tells in prose: 64-bit identifiers, files and optional values
+func refineType(structureName string, field StructureField) string {
+	var typeName = field.TypeName
+	var isIdentifier = identifierFields[field.SerializableName] || (field.SerializableName == "id" && identifierTypes[structureName])
+	if typeName == "int" && (isIdentifier || bitsRegex.MatchString(field.Description)) {
+		typeName = "int64"
+	}
+	//InputMedia documents its files as String, which may reference an upload by attach://<file_attach_name>
+	if typeName == "string" && field.SerializableName == "media" && strings.Contains(field.Description, "attach://") {
+		typeName = "InputFile"
+	}
+	if field.IsOptional && optionalPointerTypes[typeName] {
+		typeName = "*" + typeName
+	}
+	return typeName
+}
+
 func commonTypeToGoType(commonType string) reflect.Kind {
 	switch commonType {
 	case "String":
@@ -124,129 +609,20 @@ func commonTypeToGoType(commonType string) reflect.Kind {
 	return reflect.Invalid
 }
 
-//TODO: Too stupid
-func tryHard(docType string, fields *[]StructureField) string {
-
-	if strings.Contains(docType, "Array of ") {
-		var pureType = strings.Replace(docType, "Array of ", "", -1)
-		if goType := commonTypeToGoType(pureType); goType != reflect.Invalid {
+//tryHard maps documentation type which is not a common one: arrays, unions, files and objects
+func tryHard(docType string) string {
+	if strings.HasPrefix(docType, "Array of ") {
+		var itemType = strings.TrimPrefix(docType, "Array of ")
+		if goType := commonTypeToGoType(itemType); goType != reflect.Invalid {
 			return "[]" + goType.String()
-		} else {
-			if pureType == "InputMediaPhoto and InputMediaVideo" {
-				return "[]InputMediaVideo"
-			}
-
-			if pureType == "PassportElementError" {
-				return "[]PassportElementErrorDataField"
-			}
-
-			if pureType == "InlineQueryResult" {
-				return "[]InlineQueryResultCachedAudio"
-			}
-			return "[]" + pureType
-		}
-	} else {
-		if strings.Contains(docType, "InputFile") {
-			return "string"
 		}
-
-		if strings.EqualFold(docType, "InputMedia") {
-			*fields = append(*fields,
-				createOptionalStructureField("InputMediaAnimation",
-					"Represents an animation file (GIF or H.264/MPEG-4 AVC video without sound) to be sent.",
-					"media",
-					"*InputMediaAnimation"))
-
-			*fields = append(*fields,
-				createOptionalStructureField("InputMediaAudio",
-					"Represents an audio file to be treated as music to be sent.",
-					"media",
-					"*InputMediaAudio"))
-
-			*fields = append(*fields,
-				createOptionalStructureField("InputMediaPhoto",
-					"Represents a photo to be sent.",
-					"media",
-					"*InputMediaPhoto"))
-
-			*fields = append(*fields,
-				createOptionalStructureField("InputMediaVideo",
-					"Represents a video to be sent.",
-					"media",
-					"*InputMediaVideo"))
-
-			return "InputMediaDocument"
-
-		}
-
-		if strings.EqualFold(docType, "InlineQueryResult") {
-
-			return "InlineQueryResultVoice"
-			/*TODO: Add this QueryRequestTypes:
-						InlineQueryResultCachedAudio
-						InlineQueryResultCachedDocument
-						InlineQueryResultCachedGif
-						InlineQueryResultCachedMpeg4Gif
-						InlineQueryResultCachedPhoto
-						InlineQueryResultCachedSticker
-						InlineQueryResultCachedVideo
-						InlineQueryResultCachedVoice
-						InlineQueryResultArticle
-						InlineQueryResultAudio
-						InlineQueryResultContact
-						InlineQueryResultGame
-						InlineQueryResultDocument
-						InlineQueryResultGif
-						InlineQueryResultLocation
-						InlineQueryResultMpeg4Gif
-						InlineQueryResultPhoto
-						InlineQueryResultVenue
-						InlineQueryResultVideo
-						InlineQueryResultVoice
-			*/
-		}
-
-		if strings.EqualFold(docType, "InputMessageContent") {
-			*fields = append(*fields,
-				createOptionalStructureField("InputTextMessageContent",
-					"Represents the content of a text message to be sent as the result of an inline query",
-					"input_message_content",
-					"*InputTextMessageContent"))
-
-			*fields = append(*fields,
-				createOptionalStructureField("InputLocationMessageContent",
-					"Represents the content of a text message to be sent as the result of an inline query",
-					"input_message_content",
-					"*InputLocationMessageContent"))
-
-			*fields = append(*fields,
-				createOptionalStructureField("InputVenueMessageContent",
-					"Represents the content of a text message to be sent as the result of an inline query",
-					"input_message_content",
-					"*InputVenueMessageContent"))
-
-			return "*InputContactMessageContent"
-		}
-
-		if strings.EqualFold(docType, "CallbackGame") {
-			return "string //dont know what is that"
-		}
-
-		if strings.Contains(docType, "InlineKeyboardMarkup") {
-			//TODO: There is much more markups, soo add them
-			return "InlineKeyboardMarkup"
-		}
-
-		return "*" + docType
+		return "[]" + strings.TrimPrefix(tryHard(itemType), "*")
 	}
-}
-
-func createOptionalStructureField(name, description, serializableName, typeName string) StructureField {
-	return StructureField{
-		Name:             name,
-		Description:      description,
-		SerializableName: serializableName,
-		TypeName:         typeName,
-		IsOptional:       true,
+	if strings.Contains(docType, "InputFile") {
+		return "InputFile"
+	}
+	if union := unionOf(docType); union != constants.EmptyString {
+		return union
 	}
+	return "*" + docType
 }
@@ -0,0 +1,175 @@
+package main
+
+import (
+	"bytes"
+	"flag"
+	"go/ast"
+	"go/parser"
+	"go/printer"
+	"go/token"
+	"io/ioutil"
+	"path"
+	"strings"
+	"testing"
+)
+
+var update = flag.Bool("update", false, "rewrite golden files with the generator output")
+
+const fixture = "testdata/api.html"
+
+const modelCopy = "telegram/models/model.go"
+
+//goldenFiles output of every template for the fixture
+var goldenFiles = map[string]string{
+	modelTemplate:    "testdata/model.golden",
+	"client.tmpl":    "testdata/client.golden",
+	"interface.tmpl": "testdata/interface.golden",
+	"methods.tmpl":   "testdata/methods.golden",
+	"unions.tmpl":    "testdata/unions.golden",
+}
+
+//committedFiles generated files of the repository by template. Types of telegram/models/model.go are copied
+//from the generated model, only their fields are compared: doc comments of the types predate the generator
+var committedFiles = map[string]string{
+	modelTemplate:    modelCopy,
+	"client.tmpl":    clientTemplates["client.tmpl"],
+	"interface.tmpl": clientTemplates["interface.tmpl"],
+	"methods.tmpl":   clientTemplates["methods.tmpl"],
+	"unions.tmpl":    unionsOutput,
+}
+
+//generateFixture runs the generator on the fixture and returns output by template
+func generateFixture(t *testing.T) map[string][]byte {
+	t.Helper()
+	var doc, err = loadDocument(fixture, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	var structures, methods = parseDocument(doc)
+	var outputs = make(map[string][]byte)
+	if outputs[modelTemplate], err = renderTemplate(modelTemplate, structures); err != nil {
+		t.Fatal(err)
+	}
+	var client = createClientDescriptor(structures, methods)
+	for _, templateName := range []string{"client.tmpl", "interface.tmpl", "methods.tmpl", "unions.tmpl"} {
+		if outputs[templateName], err = renderClientFile(templateName, client); err != nil {
+			t.Fatal(templateName, ": ", err)
+		}
+	}
+	return outputs
+}
+
+func TestGoldenFiles(t *testing.T) {
+	var outputs = generateFixture(t)
+	for templateName, golden := range goldenFiles {
+		if *update {
+			if err := ioutil.WriteFile(golden, outputs[templateName], 0644); err != nil {
+				t.Fatal(err)
+			}
+			continue
+		}
+		var expected, err = ioutil.ReadFile(golden)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !bytes.Equal(outputs[templateName], expected) {
+			t.Errorf("%s renders the fixture differently from %s, check the changes and run go test -update", templateName, golden)
+		}
+	}
+}
+
+//TestCommittedFiles declarations generated from the fixture must be the same in the generated files of the repository,
+//so changes of the generator which would change them on regeneration are noticed
+func TestCommittedFiles(t *testing.T) {
+	var outputs = generateFixture(t)
+	for templateName, file := range committedFiles {
+		var committed, err = ioutil.ReadFile(path.Join("../..", file))
+		if err != nil {
+			t.Fatal(err)
+		}
+		var existing = declarations(t, file, committed)
+		var generated = declarations(t, templateName, outputs[templateName])
+		if len(generated) == 0 {
+			t.Errorf("%s generates nothing for the fixture", templateName)
+		}
+		for name, declaration := range generated {
+			if file == modelCopy && strings.HasSuffix(name, docSuffix) {
+				continue
+			}
+			if existing[name] != declaration {
+				t.Errorf("%s in %s differs from the generator output\nhas:\n%s\ngenerated:\n%s", name, file, existing[name], declaration)
+			}
+		}
+	}
+}
+
+const docSuffix = " doc"
+
+//declarations printed top level declarations by name. Interface methods, struct fields and constants are listed one by one
+func declarations(t *testing.T, name string, source []byte) map[string]string {
+	t.Helper()
+	var fileSet = token.NewFileSet()
+	var file, err = parser.ParseFile(fileSet, name, source, parser.ParseComments)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var print = func(node interface{}) string {
+		var buffer = new(bytes.Buffer)
+		if err := printer.Fprint(buffer, fileSet, node); err != nil {
+			t.Fatal(err)
+		}
+		return buffer.String()
+	}
+	var found = make(map[string]string)
+	for _, declaration := range file.Decls {
+		switch declaration := declaration.(type) {
+		case *ast.FuncDecl:
+			var key = declaration.Name.Name
+			if declaration.Recv != nil {
+				key = print(declaration.Recv.List[0].Type) + "." + key
+			}
+			found[key] = declaration.Doc.Text() + print(declaration)
+		case *ast.GenDecl:
+			for _, spec := range declaration.Specs {
+				switch spec := spec.(type) {
+				case *ast.ValueSpec:
+					found[spec.Names[0].Name] = print(spec)
+				case *ast.TypeSpec:
+					switch typeSpec := spec.Type.(type) {
+					case *ast.InterfaceType:
+						listFields(spec.Name.Name, typeSpec.Methods, found, print)
+					case *ast.StructType:
+						listFields(spec.Name.Name, typeSpec.Fields, found, print)
+					default:
+						found[spec.Name.Name] = print(spec)
+					}
+					found[spec.Name.Name+docSuffix] = declaration.Doc.Text()
+				}
+			}
+		}
+	}
+	return found
+}
+
+func listFields(typeName string, fields *ast.FieldList, found map[string]string, print func(node interface{}) string) {
+	for _, field := range fields.List {
+		var description = print(field.Type)
+		if field.Tag != nil {
+			description += " " + field.Tag.Value
+		}
+		description += " " + strings.TrimSpace(field.Comment.Text())
+		for _, name := range field.Names {
+			found[typeName+"."+name.Name] = description
+		}
+	}
+}
+
+//TestIdentifiers ids of users and chats are 64-bit whichever way the documentation tells it
+func TestIdentifiers(t *testing.T) {
+	var generated = declarations(t, modelTemplate, generateFixture(t)[modelTemplate])
+	for _, field := range []string{"User.Id", "Chat.Id"} {
+		if !strings.HasPrefix(generated[field], "int64 ") {
+			t.Errorf("%s is generated as %s", field, generated[field])
+		}
+	}
+}
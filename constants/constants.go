@@ -1,9 +1,5 @@
 package constants
 
-import "bitbucket.org/y4cxp543/telegram-bot/models"
-
-var Config = models.ReadConfig()
-
 /**************************************
 	   SIMPLE CONSTANTS
 ***************************************/
@@ -13,10 +9,10 @@ const Space string = " "
 const EmptyString string = ""
 const BaseUrl string = "https://www.torrentz.eu.com"
 const SearchOrderByPeers = BaseUrl + "/search?f="
-const TelegramMaxPollSize int = 10
-const NextPagePollIndex = TelegramMaxPollSize - 1
-const TelegramMaxPollTextSize int = 100
 const TreeDots = "..."
+const SecretTokenHeader = "X-Telegram-Bot-Api-Secret-Token"
+const AriaRPCPath = "/jsonrpc"
+const TelegramMaxMediaGroupSize int = 10
 
 /**************************************
    BotCommands STRUCTURE
@@ -27,6 +23,9 @@ const (
 	ByFile       BotCommands = "byFile"
 	Search       BotCommands = "search"
 	ByMagnetLink BotCommands = "byMagnet"
+	Help         BotCommands = "help"
+	Invite       BotCommands = "invite"
+	Join         BotCommands = "join"
 )
 
 func (b BotCommands) Equals(string2 string) bool {
@@ -36,6 +35,33 @@ func (b BotCommands) Equals(string2 string) bool {
 	return false
 }
 
+/**************************************
+   USER ROLES
+***************************************/
+type Role string
+
+const (
+	RoleGuest Role = "guest"
+	RoleUser  Role = "user"
+	RoleAdmin Role = "admin"
+)
+
+var roleRanks = map[Role]int{RoleGuest: 1, RoleUser: 2, RoleAdmin: 3}
+
+//IsKnown the role is one of RoleGuest, RoleUser and RoleAdmin
+func (r Role) IsKnown() bool {
+	var _, ok = roleRanks[r]
+	return ok
+}
+
+//Allows the role may run commands which require the other one
+func (r Role) Allows(required Role) bool {
+	if required == "" {
+		return true
+	}
+	return roleRanks[r] >= roleRanks[required]
+}
+
 /**************************************
    BotCommandVariables ID STRUCTURE
 ***************************************/
@@ -48,6 +74,7 @@ const (
 	ChatId    BotCommandVariables = "chatId"
 	Response  BotCommandVariables = "response"
 	TFunction BotCommandVariables = "tFunction"
+	Context   BotCommandVariables = "context"
 )
 
 /**************************************
@@ -68,18 +95,28 @@ func (b GroupID) Equals(groupId string) bool {
 }
 
 /**************************************
-   TELEGRAM IMPLEMENTED METHODS
+   UPDATE DELIVERY MODE
 ***************************************/
-type TelegramMethods string
+type UpdateMode string
 
 const (
-	GetMe       TelegramMethods = "getMe"
-	GetUpdates  TelegramMethods = "getUpdates"
-	SendMessage TelegramMethods = "sendMessage"
-	SendPoll    TelegramMethods = "sendPoll"
-	GetFile     TelegramMethods = "getFile"
+	LongPolling UpdateMode = "polling"
+	Webhook     UpdateMode = "webhook"
 )
 
+func (b UpdateMode) Equals(mode string) bool {
+	if string(b) == mode {
+		return true
+	}
+	return false
+}
+
+/**************************************
+   TELEGRAM IMPLEMENTED METHODS
+***************************************/
+//TelegramMethods values are generated by pk-update-model-v2 to telegram_methods_gen.go
+type TelegramMethods string
+
 func (b TelegramMethods) String() string {
 	return string(b)
 }
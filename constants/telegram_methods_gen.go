@@ -0,0 +1,86 @@
+package constants
+
+//Generated file
+
+const (
+	GetUpdates                      TelegramMethods = "getUpdates"
+	GetMe                           TelegramMethods = "getMe"
+	LogOut                          TelegramMethods = "logOut"
+	Close                           TelegramMethods = "close"
+	SetWebhook                      TelegramMethods = "setWebhook"
+	DeleteWebhook                   TelegramMethods = "deleteWebhook"
+	GetWebhookInfo                  TelegramMethods = "getWebhookInfo"
+	SendMessage                     TelegramMethods = "sendMessage"
+	ForwardMessage                  TelegramMethods = "forwardMessage"
+	SendPhoto                       TelegramMethods = "sendPhoto"
+	SendAudio                       TelegramMethods = "sendAudio"
+	SendDocument                    TelegramMethods = "sendDocument"
+	SendVideo                       TelegramMethods = "sendVideo"
+	SendAnimation                   TelegramMethods = "sendAnimation"
+	SendVoice                       TelegramMethods = "sendVoice"
+	SendVideoNote                   TelegramMethods = "sendVideoNote"
+	SendMediaGroup                  TelegramMethods = "sendMediaGroup"
+	SendLocation                    TelegramMethods = "sendLocation"
+	EditMessageLiveLocation         TelegramMethods = "editMessageLiveLocation"
+	StopMessageLiveLocation         TelegramMethods = "stopMessageLiveLocation"
+	SendVenue                       TelegramMethods = "sendVenue"
+	SendContact                     TelegramMethods = "sendContact"
+	SendPoll                        TelegramMethods = "sendPoll"
+	SendChatAction                  TelegramMethods = "sendChatAction"
+	GetUserProfilePhotos            TelegramMethods = "getUserProfilePhotos"
+	GetFile                         TelegramMethods = "getFile"
+	KickChatMember                  TelegramMethods = "kickChatMember"
+	UnbanChatMember                 TelegramMethods = "unbanChatMember"
+	RestrictChatMember              TelegramMethods = "restrictChatMember"
+	PromoteChatMember               TelegramMethods = "promoteChatMember"
+	SetChatAdministratorCustomTitle TelegramMethods = "setChatAdministratorCustomTitle"
+	SetChatPermissions              TelegramMethods = "setChatPermissions"
+	ExportChatInviteLink            TelegramMethods = "exportChatInviteLink"
+	SetChatPhoto                    TelegramMethods = "setChatPhoto"
+	DeleteChatPhoto                 TelegramMethods = "deleteChatPhoto"
+	SetChatTitle                    TelegramMethods = "setChatTitle"
+	SetChatDescription              TelegramMethods = "setChatDescription"
+	PinChatMessage                  TelegramMethods = "pinChatMessage"
+	UnpinChatMessage                TelegramMethods = "unpinChatMessage"
+	LeaveChat                       TelegramMethods = "leaveChat"
+	GetChat                         TelegramMethods = "getChat"
+	GetChatAdministrators           TelegramMethods = "getChatAdministrators"
+	GetChatMembersCount             TelegramMethods = "getChatMembersCount"
+	GetChatMember                   TelegramMethods = "getChatMember"
+	SetChatStickerSet               TelegramMethods = "setChatStickerSet"
+	DeleteChatStickerSet            TelegramMethods = "deleteChatStickerSet"
+	AnswerCallbackQuery             TelegramMethods = "answerCallbackQuery"
+	EditMessageText                 TelegramMethods = "editMessageText"
+	EditMessageCaption              TelegramMethods = "editMessageCaption"
+	EditMessageMedia                TelegramMethods = "editMessageMedia"
+	EditMessageReplyMarkup          TelegramMethods = "editMessageReplyMarkup"
+	StopPoll                        TelegramMethods = "stopPoll"
+	DeleteMessage                   TelegramMethods = "deleteMessage"
+	SendSticker                     TelegramMethods = "sendSticker"
+	GetStickerSet                   TelegramMethods = "getStickerSet"
+	UploadStickerFile               TelegramMethods = "uploadStickerFile"
+	CreateNewStickerSet             TelegramMethods = "createNewStickerSet"
+	AddStickerToSet                 TelegramMethods = "addStickerToSet"
+	SetStickerPositionInSet         TelegramMethods = "setStickerPositionInSet"
+	DeleteStickerFromSet            TelegramMethods = "deleteStickerFromSet"
+	AnswerInlineQuery               TelegramMethods = "answerInlineQuery"
+	SendInvoice                     TelegramMethods = "sendInvoice"
+	AnswerShippingQuery             TelegramMethods = "answerShippingQuery"
+	AnswerPreCheckoutQuery          TelegramMethods = "answerPreCheckoutQuery"
+	SetPassportDataErrors           TelegramMethods = "setPassportDataErrors"
+	SendGame                        TelegramMethods = "sendGame"
+	SetGameScore                    TelegramMethods = "setGameScore"
+	GetGameHighScores               TelegramMethods = "getGameHighScores"
+	CopyMessage                     TelegramMethods = "copyMessage"
+	SendDice                        TelegramMethods = "sendDice"
+	BanChatMember                   TelegramMethods = "banChatMember"
+	CreateChatInviteLink            TelegramMethods = "createChatInviteLink"
+	EditChatInviteLink              TelegramMethods = "editChatInviteLink"
+	RevokeChatInviteLink            TelegramMethods = "revokeChatInviteLink"
+	UnpinAllChatMessages            TelegramMethods = "unpinAllChatMessages"
+	GetChatMemberCount              TelegramMethods = "getChatMemberCount"
+	SetMyCommands                   TelegramMethods = "setMyCommands"
+	DeleteMyCommands                TelegramMethods = "deleteMyCommands"
+	GetMyCommands                   TelegramMethods = "getMyCommands"
+	SetStickerSetThumb              TelegramMethods = "setStickerSetThumb"
+)
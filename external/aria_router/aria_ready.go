@@ -0,0 +1,46 @@
+package aria_router
+
+import (
+	"bitbucket.org/y4cxp543/aria2c"
+	"bitbucket.org/y4cxp543/telegram-bot/util"
+	"errors"
+	"github.com/asaskevich/EventBus"
+	"log"
+	"time"
+)
+
+const readyProbeInterval = time.Second
+
+//WaitReady sends aria2.getVersion until aria2 answers or timeout expires
+func WaitReady(api aria2c.AriaWSSender, bus EventBus.Bus, timeout time.Duration) error {
+	var ready = make(chan *aria2c.Response, 1)
+	var onVersion = func(req *aria2c.Request, resp *aria2c.Response) {
+		select {
+		case ready <- resp:
+		default:
+		}
+	}
+	if err := bus.SubscribeAsync(aria2c.GetVersion, onVersion, false); err != nil {
+		return err
+	}
+	defer func() {
+		_ = bus.Unsubscribe(aria2c.GetVersion, onVersion)
+	}()
+
+	var deadline = time.After(timeout)
+	for {
+		api.GetVersion()
+		select {
+		case resp := <-ready:
+			if resp.Error != nil {
+				return errors.New("aria2 rejected getVersion: " + util.GetAriaError(resp))
+			}
+			log.Println("Aria2c is ready: ", resp.Result)
+			return nil
+		case <-time.After(readyProbeInterval):
+			continue
+		case <-deadline:
+			return errors.New("aria2 did not answer getVersion in " + timeout.String())
+		}
+	}
+}
@@ -4,7 +4,6 @@ import (
 	"bitbucket.org/y4cxp543/aria2c"
 	"bitbucket.org/y4cxp543/telegram-bot/constants"
 	"bitbucket.org/y4cxp543/telegram-bot/interfaces"
-	"bitbucket.org/y4cxp543/telegram-bot/util"
 	"encoding/json"
 	"github.com/asaskevich/EventBus"
 	"log"
@@ -25,9 +24,9 @@ func NewWSRouter(cache interfaces.Cache, respHandler aria2c.AriaWSResponseHandle
 }
 
 func (router *wsRouter) Route(resp *aria2c.Response) {
+	//Errors are still routed to the response handler, so the caller waiting for an answer is notified
 	if resp.Error != nil {
 		router.HandleAriaError(resp)
-		return
 	}
 
 	//Notification
@@ -65,9 +64,9 @@ func NewNotificationHandler(Cache interfaces.Cache, EventBus EventBus.Bus) aria2
 	}
 }
 
+//Notifications are only logged, status messages of downloads follow them by aria2.tellStatus
 func (n *NotificationHandler) OnDownloadStart(resp *aria2c.Response) {
-	var gid = util.GetGid(resp)
-	n.EventBus.Publish(gid)
+	log.Println("OnDownloadStart: ", resp)
 }
 
 func (n *NotificationHandler) OnDownloadPause(resp *aria2c.Response) {
@@ -87,8 +86,7 @@ func (n *NotificationHandler) OnDownloadError(resp *aria2c.Response) {
 }
 
 func (n *NotificationHandler) OnBtDownloadComplete(resp *aria2c.Response) {
-	var gid = util.GetGid(resp)
-	n.EventBus.Publish(gid)
+	log.Println("OnBtDownloadComplete: ", resp)
 }
 
 type ResponseHandler struct {
@@ -103,12 +101,14 @@ func NewResponseHandler(Cache interfaces.Cache, EventBus EventBus.Bus) aria2c.Ar
 	}
 }
 
+//ReceiveAddUri and ReceiveAddTorrent publish the answer to the topic named after the request method,
+//so subscribers don't race with the response when subscribing to the request id
 func (w ResponseHandler) ReceiveAddUri(req *aria2c.Request, resp *aria2c.Response) {
-	log.Println(resp)
+	w.EventBus.Publish(req.Method, req, resp)
 }
 
 func (w ResponseHandler) ReceiveAddTorrent(req *aria2c.Request, resp *aria2c.Response) {
-	w.EventBus.Publish(req.Id, req, resp)
+	w.EventBus.Publish(req.Method, req, resp)
 }
 func (w ResponseHandler) ReceiveGetPeers(req *aria2c.Request, resp *aria2c.Response) {
 }
@@ -133,10 +133,12 @@ func (w ResponseHandler) ReceiveForceRemove(req *aria2c.Request, resp *aria2c.Re
 func (w ResponseHandler) ReceiveChangePosition(req *aria2c.Request, resp *aria2c.Response) {
 }
 func (w ResponseHandler) ReceiveTellStatus(req *aria2c.Request, resp *aria2c.Response) {
+	w.EventBus.Publish(req.Method, req, resp)
 }
 func (w ResponseHandler) ReceiveGetUris(req *aria2c.Request, resp *aria2c.Response) {
 }
 func (w ResponseHandler) ReceiveGetFiles(req *aria2c.Request, resp *aria2c.Response) {
+	w.EventBus.Publish(req.Method, req, resp)
 }
 func (w ResponseHandler) ReceiveGetServers(req *aria2c.Request, resp *aria2c.Response) {
 }
@@ -161,6 +163,7 @@ func (w ResponseHandler) ReceivePurgeDownloadResult(req *aria2c.Request, resp *a
 func (w ResponseHandler) ReceiveRemoveDownloadResult(req *aria2c.Request, resp *aria2c.Response) {
 }
 func (w ResponseHandler) ReceiveGetVersion(req *aria2c.Request, resp *aria2c.Response) {
+	w.EventBus.Publish(req.Method, req, resp)
 }
 func (w ResponseHandler) ReceiveGetSessionInfo(req *aria2c.Request, resp *aria2c.Response) {
 }
@@ -7,6 +7,7 @@ import (
 	"encoding/json"
 	"github.com/gorilla/websocket"
 	"log"
+	"sync"
 )
 
 type AuthAriaWS struct {
@@ -14,9 +15,11 @@ type AuthAriaWS struct {
 	Cache             interfaces.Cache
 	handlerRegistered bool
 	Token             string
+	//writeMutex websocket connection supports only one concurrent writer
+	writeMutex sync.Mutex
 }
 
-func NewLocalAriaWS(cache interfaces.Cache, token string) aria2c.AriaWSSender {
+func NewLocalAriaWS(cache interfaces.Cache, token string) interfaces.IAriaApi {
 	return &AuthAriaWS{handlerRegistered: false, Cache: cache, Token: token}
 }
 
@@ -58,7 +61,11 @@ func (ws *AuthAriaWS) execute(method string, param interface{}) string {
 	var byteArr,_ = json.MarshalIndent(request, "", "    ")
 	log.Println("Aria2c Request: ", string(byteArr))
 	ws.Cache.Put(request.Id, request)
-	_ = ws.Conn.WriteJSON(request)
+	ws.writeMutex.Lock()
+	defer ws.writeMutex.Unlock()
+	if err := ws.Conn.WriteJSON(request); err != nil {
+		log.Println("Cannot send request to Aria2c: ", err)
+	}
 	return request.Id
 }
 
@@ -118,6 +125,14 @@ func (ws *AuthAriaWS) TellStatus() string {
 	return ws.execute(aria2c.TellStatus, nil)
 }
 
+func (ws *AuthAriaWS) UnpauseOf(gid string) string {
+	return ws.execute(aria2c.Unpause, gid)
+}
+
+func (ws *AuthAriaWS) TellStatusOf(gid string) string {
+	return ws.execute(aria2c.TellStatus, gid)
+}
+
 func (ws *AuthAriaWS) GetUris() string {
 	return ws.execute(aria2c.GetUris, nil)
 }
@@ -126,6 +141,10 @@ func (ws *AuthAriaWS) GetFiles() string {
 	return ws.execute(aria2c.GetFiles, nil)
 }
 
+func (ws *AuthAriaWS) GetFilesOf(gid string) string {
+	return ws.execute(aria2c.GetFiles, gid)
+}
+
 func (ws *AuthAriaWS) GetServers() string {
 	return ws.execute(aria2c.GetServers, nil)
 }
@@ -22,7 +22,11 @@ func Search(condition string) ([]Result, error) {
 	}
 	condition = strings.ReplaceAll(condition, constants.Space, "+")
 	log.Printf("GET: %s%s", constants.SearchOrderByPeers, condition)
-	response,_ := http.Get(constants.SearchOrderByPeers + condition)
+	response, err := http.Get(constants.SearchOrderByPeers + condition)
+	if err != nil {
+		log.Println(err)
+		return nil, err
+	}
 	log.Printf("Response Code: %d, Content-Length: %d", response.StatusCode, response.ContentLength)
 	result:= string(util.GetBytes(response)[:])
 	regex := regexp.MustCompile("href=(?P<Link>\\/[a-zA-Z0-9]+)>(?P<Name>[а-яА-Яa-zA-Z0-9\\s-\\(\\)\\.\\_\\[\\]]+)</a>.*title=\\d+>(?P<Age>[0-9]+[\\s|year|month|day|D]*s?)</span><span>(?P<Size>\\d+[\\sGB|MB|KB]+)")
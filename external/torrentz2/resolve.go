@@ -0,0 +1,67 @@
+package torrentz2
+
+import (
+	"bitbucket.org/y4cxp543/telegram-bot/constants"
+	"bitbucket.org/y4cxp543/telegram-bot/util"
+	"errors"
+	"fmt"
+	"html"
+	"log"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strings"
+	"time"
+)
+
+const magnetPrefix = "magnet:?xt=urn:btih:"
+
+var resolveClient = &http.Client{Timeout: 15 * time.Second}
+
+//magnetRegex magnet link of the detail page, infoHashRegex its info hash when the page shows no link
+var magnetRegex = regexp.MustCompile(`magnet:\?xt=urn:btih:[^"'<>\s]+`)
+var infoHashRegex = regexp.MustCompile(`(?i)\b(?:info[\s_-]*hash|btih)(?:<[^>]*>|\W){0,20}([0-9a-f]{40}|[a-z2-7]{32})\b`)
+
+//Resolve loads detail page of the result and returns its magnet link
+func Resolve(result Result) (string, error) {
+	if !strings.HasPrefix(result.Link, "/") {
+		return constants.EmptyString, errors.New("wrong result link: " + result.Link)
+	}
+	log.Printf("GET: %s%s", constants.BaseUrl, result.Link)
+	response, err := resolveClient.Get(constants.BaseUrl + result.Link)
+	if err != nil {
+		return constants.EmptyString, err
+	}
+	if response.StatusCode != http.StatusOK {
+		_ = response.Body.Close()
+		return constants.EmptyString, fmt.Errorf("detail page answered %d", response.StatusCode)
+	}
+	var page = string(util.GetBytes(response))
+	_ = response.Body.Close()
+	return magnetFromPage(page, result.Name)
+}
+
+func magnetFromPage(page, name string) (string, error) {
+	if magnet := magnetRegex.FindString(page); magnet != constants.EmptyString {
+		return html.UnescapeString(magnet), nil
+	}
+	if match := infoHashRegex.FindStringSubmatch(page); match != nil {
+		return MagnetFromInfoHash(match[1], name), nil
+	}
+	return constants.EmptyString, errors.New("detail page has neither magnet link nor info hash")
+}
+
+//MagnetFromInfoHash builds magnet link, the name is shown by clients until metadata is downloaded
+func MagnetFromInfoHash(infoHash, name string) string {
+	//Hex hashes are written in lower case, base32 ones in upper case
+	if len(infoHash) == 40 {
+		infoHash = strings.ToLower(infoHash)
+	} else {
+		infoHash = strings.ToUpper(infoHash)
+	}
+	var magnet = magnetPrefix + infoHash
+	if name != constants.EmptyString {
+		magnet += "&dn=" + url.QueryEscape(name)
+	}
+	return magnet
+}
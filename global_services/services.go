@@ -4,45 +4,139 @@ import (
 	"bitbucket.org/y4cxp543/aria2c"
 	"bitbucket.org/y4cxp543/telegram-bot/cache"
 	"bitbucket.org/y4cxp543/telegram-bot/constants"
+	"bitbucket.org/y4cxp543/telegram-bot/external/aria_router"
+	"bitbucket.org/y4cxp543/telegram-bot/external/torrentz2"
+	"bitbucket.org/y4cxp543/telegram-bot/interfaces"
+	"bitbucket.org/y4cxp543/telegram-bot/models"
 	"bitbucket.org/y4cxp543/telegram-bot/telegram"
+	"bitbucket.org/y4cxp543/telegram-bot/telegram/access"
 	"bitbucket.org/y4cxp543/telegram-bot/telegram/commands"
+	"bitbucket.org/y4cxp543/telegram-bot/telegram/inline"
+	"bitbucket.org/y4cxp543/telegram-bot/telegram/limiter"
+	"bitbucket.org/y4cxp543/telegram-bot/telegram/pager"
+	"bitbucket.org/y4cxp543/telegram-bot/telegram/progress"
+	"context"
 	"github.com/asaskevich/EventBus"
+	"log"
+	"os"
+	"strconv"
+	"time"
 )
 
-/*var wsConn = aria2c.NewAriaWsConnector("localhost", strconv.Itoa(constants.Config.Aria2C.Port), "/jsonrpc")
-var notificationHandler = aria_router.NewNotificationHandler(GlobalCache, EBus)
-var responseHandler = aria_router.NewResponseHandler(GlobalCache, EBus)*/
+const ariaReadyTimeout = 30 * time.Second
+
+//Config is read from config.toml of the working directory, services get their settings from it
+var Config = models.ReadConfig()
+
 var commandsCache = new(cache.TemporaryCache)
 
 var EBus = EventBus.New()
 
-var CommandProcessor = commands.NewCommandProcessor(commandsCache, EBus, TFunctions)
-
 var AriaDaemon = aria2c.NewAriaDaemon(aria2c.AriaConfig{
 	EnableRPC:              true,
-	DownloadsDir:           constants.Config.Aria2C.DownloadDir,
-	LogFile:                constants.Config.Aria2C.LogDir,
-	MaxConcurrentDownloads: constants.Config.Aria2C.MaxConcurrentDownloads,
-	MaxConnPerServer:       constants.Config.Aria2C.MaxConnectionsPerServer,
-	Port:                   constants.Config.Aria2C.Port,
-	RpcSecret:              constants.Config.Aria2C.Secret,
-	LogLevel:               constants.Config.Aria2C.LogLevel,
+	DownloadsDir:           Config.Aria2C.DownloadDir,
+	LogFile:                Config.Aria2C.LogDir,
+	MaxConcurrentDownloads: Config.Aria2C.MaxConcurrentDownloads,
+	MaxConnPerServer:       Config.Aria2C.MaxConnectionsPerServer,
+	Port:                   Config.Aria2C.Port,
+	RpcSecret:              Config.Aria2C.Secret,
+	LogLevel:               Config.Aria2C.LogLevel,
 }).Start()
 
-/*var GlobalCache interfaces.Cache = new(cache.TemporaryCache)*/
-
 //Configure Aria2C API
-/*var AriaCache interfaces.Cache = new(cache.TemporaryCache)
+var AriaCache interfaces.Cache = new(cache.TemporaryCache)
+var notificationHandler = aria_router.NewNotificationHandler(AriaCache, EBus)
+var responseHandler = aria_router.NewResponseHandler(AriaCache, EBus)
 var router = aria_router.NewWSRouter(AriaCache, responseHandler, notificationHandler)
-var AriaApi = wsConn.ConnectAndRoute(aria_router.NewLocalAriaWS(AriaCache, constants.Config.Aria2C.Secret), router)*/
 
+//AriaApi and CommandProcessor are available after GlobalServicesStart
+var AriaApi interfaces.IAriaApi
+var CommandProcessor interfaces.ICommandProcessor
+
+//TFunctions sends messages through the rate limiting scheduler
+var TFunctions = limiter.NewScheduler(
+	telegram.NewTFunctions(Config.Client.RequestURL, Config.Client.RequestFile,
+		telegram.NewHTTPClient(), time.Duration(Config.Client.RequestTimeout)*time.Second, Config.Client.LocalMode),
+	limiter.DefaultLimits,
+	limiter.SystemClock{})
+
+//ResultPager browses search results, its buttons are routed by TelegramBot
+var ResultPager = pager.NewPager(TFunctions, limiter.SystemClock{}, pager.DefaultTTL, pager.DefaultPageSize)
 
+var OffsetStore = cache.NewOffsetStore(Config.Client.OffsetFile)
 
-var TFunctions = telegram.NewTFunctions(constants.Config.Client.RequestURL, constants.Config.Client.RequestFile)
+var ConversationStore = cache.NewConversationStore(Config.Client.ConversationFile)
 
-var TelegramBot = telegram.NewBot(constants.Config.Client.RequestFile, constants.Config.Client.RequestFile, TFunctions)
+var TelegramBot = telegram.NewBot(Config.Client, TFunctions, OffsetStore, ConversationStore)
+
+//Access allowlist and roles from the configuration, extended by users approved in Telegram
+var Access = access.NewAccess(Config.Access, cache.NewRoleStore(Config.Access.UsersFile),
+	TFunctions, limiter.SystemClock{}, access.DefaultInviteTTL)
+
+//GlobalServicesStart logs the bot out of the previous Bot API server if the server is changed,
+//connects to aria2 WebSocket RPC and waits until aria2 answers
+func GlobalServicesStart() error {
+	if err := telegram.SwitchServer(context.Background(), cache.NewServerStore(Config.Client.ServerFile),
+		Config.Client, telegram.NewHTTPClient()); err != nil {
+		log.Println(err)
+	}
+	var wsConn = aria2c.NewAriaWsConnector("localhost", strconv.Itoa(Config.Aria2C.Port), constants.AriaRPCPath)
+	AriaApi = aria_router.NewLocalAriaWS(AriaCache, Config.Aria2C.Secret)
+	wsConn.ConnectAndRoute(AriaApi, router)
+	if err := aria_router.WaitReady(AriaApi, EBus, ariaReadyTimeout); err != nil {
+		return err
+	}
+	var tracker = progress.NewTracker(AriaApi, EBus, TFunctions, limiter.SystemClock{}, time.Duration(Config.Aria2C.StatusInterval)*time.Second)
+	TelegramBot.RegisterCallback(progress.Prefix, tracker.HandleCallback)
+	CommandProcessor = commands.NewCommandProcessor(commandsCache, EBus, TFunctions, AriaApi, ResultPager, TelegramBot.Conversations(), tracker, Config.Aria2C.DownloadDir)
+	TelegramBot.SetRoleResolver(Access.Resolver())
+	TelegramBot.SetMenuRoles(Access.MenuRoles())
+	TelegramBot.Use(Access.Middleware())
+	TelegramBot.RegisterCallback(access.Prefix, Access.HandleCallback)
+	TelegramBot.RegisterCommands(Access.Commands()...)
+	TelegramBot.RegisterCallback(pager.Prefix, ResultPager.HandleCallback)
+	TelegramBot.RegisterCommands(commands.Commands(CommandProcessor)...)
+	var inlineSearch = inline.NewSearch(Config.Inline, TFunctions, limiter.SystemClock{}, torrentz2.Search, CommandProcessor.DownloadResult)
+	TelegramBot.RegisterCallback(inline.Prefix, inlineSearch.HandleCallback)
+	TelegramBot.SetInlineSearch(inlineSearch)
+	return nil
+}
+
+//GlobalServicesStop shuts services down in order before the context is done: stops receiving updates and waits
+//for their handlers, sends queued messages, closes aria2 WebSocket and stops aria2 daemon
+func GlobalServicesStop(ctx context.Context) {
+	TelegramBot.Stop(ctx)
+	if err := TFunctions.Flush(ctx); err != nil {
+		log.Println("Queued messages are not sent: ", err)
+	}
+	if AriaApi != nil {
+		if err := AriaApi.Disconnect(); err != nil {
+			log.Println("Cannot close aria2 connection: ", err)
+		}
+	}
+	stopAriaDaemon(ctx)
+}
 
-func GlobalServicesStop() {
-	/*_ = AriaApi.Disconnect()*/
-	/*_ = AriaDaemon.Process.Kill()*/
+//stopAriaDaemon interrupts aria2, so it saves the session, and kills it if it does not exit in time
+func stopAriaDaemon(ctx context.Context) {
+	if AriaDaemon == nil || AriaDaemon.Process == nil {
+		return
+	}
+	var process = AriaDaemon.Process
+	if err := process.Signal(os.Interrupt); err != nil {
+		//Interrupt is not supported on Windows
+		_ = process.Kill()
+		return
+	}
+	var exited = make(chan struct{})
+	go func() {
+		_, _ = process.Wait()
+		close(exited)
+	}()
+	select {
+	case <-exited:
+	case <-ctx.Done():
+		log.Println("aria2 has not exited in time, killing it")
+		_ = process.Kill()
+	}
 }
@@ -0,0 +1,14 @@
+package interfaces
+
+import "bitbucket.org/y4cxp543/aria2c"
+
+//IAriaApi aria2 RPC requests, AriaWSSender sends most of them without parameters
+type IAriaApi interface {
+	aria2c.AriaWSSender
+	//GetFilesOf requests files of the download identified by gid
+	GetFilesOf(gid string) string
+	//TellStatusOf requests progress of the download identified by gid
+	TellStatusOf(gid string) string
+	//UnpauseOf resumes the paused download identified by gid
+	UnpauseOf(gid string) string
+}
@@ -1,22 +1,22 @@
 package interfaces
 
 import (
+	"bitbucket.org/y4cxp543/telegram-bot/telegram/command"
 	"bitbucket.org/y4cxp543/telegram-bot/telegram/models"
+	"context"
 )
 
-type IBotCommandObserver interface {
-	Register(Func IBotCommandFunc, observerID string)
-	Unregister(observerID string)
-	NotifyAll(arg BotCommandArgument)
-}
-
 type IBotCommandFunc func(BotCommandArgument)
 
 type BotCommandArgument struct {
+	//Context of the bot, cancelled when shutdown runs out of time. Kept by handlers replying later
+	Context           context.Context
 	Command, Argument string
+	Args              command.Arguments
 	MessageId         int
-	ChatId            uint64
+	ChatId            int64
+	UserId            int64
 	//TFunction         *ITelegramFunctions
 	Response          *models.Update
 	//Cache             Cache
-}
\ No newline at end of file
+}
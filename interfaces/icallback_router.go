@@ -0,0 +1,25 @@
+package interfaces
+
+import (
+	"bitbucket.org/y4cxp543/telegram-bot/telegram/models"
+	"context"
+)
+
+//ICallbackRouter dispatches callback queries of inline keyboard buttons by the prefix of callback_data
+type ICallbackRouter interface {
+	Handle(prefix string, handler ICallbackFunc)
+	Route(ctx context.Context, query *models.CallbackQuery)
+}
+
+//ICallbackFunc handles pressed button and returns notification text shown to the user, it may be empty
+type ICallbackFunc func(arg CallbackArgument) (string, error)
+
+type CallbackArgument struct {
+	Context         context.Context
+	Prefix          string
+	Args            []string
+	ChatId          int64
+	MessageId       int
+	InlineMessageId string
+	Query           *models.CallbackQuery
+}
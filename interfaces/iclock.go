@@ -0,0 +1,9 @@
+package interfaces
+
+import "time"
+
+//Clock abstracts time, so time dependent code can be driven by a fake clock
+type Clock interface {
+	Now() time.Time
+	After(d time.Duration) <-chan time.Time
+}
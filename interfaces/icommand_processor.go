@@ -0,0 +1,10 @@
+package interfaces
+
+import "bitbucket.org/y4cxp543/telegram-bot/external/torrentz2"
+
+type ICommandProcessor interface {
+	ProcessSearchTorrents(botCommandArg BotCommandArgument)
+	ProcessDocument(botCommandArg BotCommandArgument)
+	ProcessMagnetLink(botCommandArg BotCommandArgument)
+	DownloadResult(arg CallbackArgument, result torrentz2.Result) (string, error)
+}
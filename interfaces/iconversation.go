@@ -0,0 +1,45 @@
+package interfaces
+
+import (
+	"bitbucket.org/y4cxp543/telegram-bot/telegram/models"
+	"context"
+	"time"
+)
+
+//ConversationState step of the dialog with the user in the chat. Data keeps answers of the previous steps
+type ConversationState struct {
+	ChatId  int64             `json:"chat_id"`
+	UserId  int64             `json:"user_id"`
+	Step    string            `json:"step"`
+	Data    map[string]string `json:"data,omitempty"`
+	Expires time.Time         `json:"expires"`
+}
+
+//ConversationInput answer of the user: a message (text, document...) or a pressed button
+type ConversationInput struct {
+	Context   context.Context
+	ChatId    int64
+	UserId    int64
+	MessageId int
+	Text      string
+	Message   *models.Message
+	Callback  *models.CallbackQuery
+}
+
+//IConversationStepFunc handles the answer on the step and returns the next step, empty one ends the dialog
+type IConversationStepFunc func(state *ConversationState, input ConversationInput) (string, error)
+
+//IConversations lets handlers ask follow-up questions and wait for the answer
+type IConversations interface {
+	//Ask waits for the next answer of the user in the chat and passes it to the handler of the step
+	Ask(chatId, userId int64, step string, data map[string]string)
+	HandleStep(step string, handler IConversationStepFunc)
+	Cancel(chatId, userId int64) bool
+}
+
+//ConversationStore persists dialogs, so they survive restarts
+type ConversationStore interface {
+	LoadAll() ([]ConversationState, error)
+	Save(state ConversationState) error
+	Delete(chatId, userId int64) error
+}
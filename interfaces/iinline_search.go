@@ -0,0 +1,12 @@
+package interfaces
+
+import (
+	"bitbucket.org/y4cxp543/telegram-bot/telegram/models"
+	"context"
+)
+
+//IInlineSearch answers inline queries "@bot words" typed in any chat and tracks the results users post
+type IInlineSearch interface {
+	HandleQuery(ctx context.Context, query *models.InlineQuery) error
+	HandleChosen(ctx context.Context, chosen *models.ChosenInlineResult) error
+}
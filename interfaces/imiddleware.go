@@ -0,0 +1,33 @@
+package interfaces
+
+import (
+	"context"
+	"log"
+)
+
+//Kinds of handlers wrapped by middleware
+const (
+	CommandHandler  = "command"
+	CallbackHandler = "callback"
+	MessageHandler  = "message"
+	InlineHandler   = "inline"
+)
+
+//HandlerRequest describes the update passed to a command, callback or message handler
+type HandlerRequest struct {
+	Context   context.Context
+	Id        string
+	Kind      string
+	Name      string
+	ChatId    int64
+	UserId    int64
+	MessageId int
+	//Logger prefixes lines with the request, set by logging middleware
+	Logger *log.Logger
+}
+
+//IHandler handler call, returns error if the handler failed or was not allowed to run
+type IHandler func(request *HandlerRequest) error
+
+//IMiddleware wraps handler, e.g. to recover panics or to check access
+type IMiddleware func(next IHandler) IHandler
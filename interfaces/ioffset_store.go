@@ -0,0 +1,10 @@
+package interfaces
+
+//OffsetStore keeps the update_id of the last update handled by every observer,
+//so getUpdates can resume from the next one after a restart
+type OffsetStore interface {
+	//LastUpdateId returns the last committed update_id or 0 if nothing was committed yet
+	LastUpdateId() (int, error)
+	//Commit stores updateId if it is greater than the committed one
+	Commit(updateId int) error
+}
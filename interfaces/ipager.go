@@ -0,0 +1,13 @@
+package interfaces
+
+import "context"
+
+//IPager shows a list in one message and turns its pages by editing the message
+type IPager interface {
+	//Show sends first page of items as a reply, onPick is called when the button of an item is pressed.
+	//Only the user with userId may press the buttons, 0 lets everyone press them
+	Show(ctx context.Context, chatId, userId int64, replyToMessageId int, title string, items []string, onPick IPickFunc) error
+}
+
+//IPickFunc handles picked item by its index in the list and returns notification text shown to the user
+type IPickFunc func(arg CallbackArgument, index int) (string, error)
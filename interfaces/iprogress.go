@@ -0,0 +1,10 @@
+package interfaces
+
+import "context"
+
+//IProgress keeps one status message per download up to date
+type IProgress interface {
+	//Track sends status message of the download as a reply and edits it until the download is finished.
+	//onComplete gets gid of the downloaded files, magnet links get a new gid once metadata is downloaded
+	Track(ctx context.Context, chatId int64, replyToMessageId int, gid, name string, onComplete func(gid string)) error
+}
@@ -0,0 +1,9 @@
+package interfaces
+
+import "bitbucket.org/y4cxp543/telegram-bot/constants"
+
+//RoleStore keeps roles granted from Telegram, e.g. to users approved by an admin, between restarts
+type RoleStore interface {
+	LoadAll() (map[int64]constants.Role, error)
+	Save(userId int64, role constants.Role) error
+}
@@ -0,0 +1,8 @@
+package interfaces
+
+//ServerStore keeps the Bot API server the bot used last
+type ServerStore interface {
+	//LastServer returns url of the server or empty string if it is not known yet
+	LastServer() (string, error)
+	Save(server string) error
+}
@@ -0,0 +1,91 @@
+package interfaces
+
+//Generated file
+
+import (
+	"bitbucket.org/y4cxp543/telegram-bot/telegram/models"
+	"context"
+)
+
+//IBotAPI Bot API methods generated from the documentation
+type IBotAPI interface {
+	GetMe(ctx context.Context) (models.User, error)
+	LogOut(ctx context.Context) (bool, error)
+	Close(ctx context.Context) (bool, error)
+	SetWebhook(ctx context.Context, request models.SetWebhook) (bool, error)
+	DeleteWebhook(ctx context.Context, request models.DeleteWebhook) (bool, error)
+	GetWebhookInfo(ctx context.Context) (models.WebhookInfo, error)
+	SendMessage(ctx context.Context, request models.SendMessage) (models.Message, error)
+	ForwardMessage(ctx context.Context, request models.ForwardMessage) (models.Message, error)
+	SendPhoto(ctx context.Context, request models.SendPhoto) (models.Message, error)
+	SendAudio(ctx context.Context, request models.SendAudio) (models.Message, error)
+	SendDocument(ctx context.Context, request models.SendDocument) (models.Message, error)
+	SendVideo(ctx context.Context, request models.SendVideo) (models.Message, error)
+	SendAnimation(ctx context.Context, request models.SendAnimation) (models.Message, error)
+	SendVoice(ctx context.Context, request models.SendVoice) (models.Message, error)
+	SendVideoNote(ctx context.Context, request models.SendVideoNote) (models.Message, error)
+	SendMediaGroup(ctx context.Context, request models.SendMediaGroup) ([]models.Message, error)
+	SendLocation(ctx context.Context, request models.SendLocation) (models.Message, error)
+	EditMessageLiveLocation(ctx context.Context, request models.EditMessageLiveLocation) (models.MessageOrTrue, error)
+	StopMessageLiveLocation(ctx context.Context, request models.StopMessageLiveLocation) (models.MessageOrTrue, error)
+	SendVenue(ctx context.Context, request models.SendVenue) (models.Message, error)
+	SendContact(ctx context.Context, request models.SendContact) (models.Message, error)
+	SendPoll(ctx context.Context, request models.SendPoll) (models.Message, error)
+	SendChatAction(ctx context.Context, request models.SendChatAction) (bool, error)
+	GetUserProfilePhotos(ctx context.Context, request models.GetUserProfilePhotos) (models.UserProfilePhotos, error)
+	GetFile(ctx context.Context, request models.GetFile) (models.File, error)
+	KickChatMember(ctx context.Context, request models.KickChatMember) (bool, error)
+	UnbanChatMember(ctx context.Context, request models.UnbanChatMember) (bool, error)
+	RestrictChatMember(ctx context.Context, request models.RestrictChatMember) (bool, error)
+	PromoteChatMember(ctx context.Context, request models.PromoteChatMember) (bool, error)
+	SetChatAdministratorCustomTitle(ctx context.Context, request models.SetChatAdministratorCustomTitle) (bool, error)
+	SetChatPermissions(ctx context.Context, request models.SetChatPermissions) (bool, error)
+	ExportChatInviteLink(ctx context.Context, request models.ExportChatInviteLink) (string, error)
+	SetChatPhoto(ctx context.Context, request models.SetChatPhoto) (bool, error)
+	DeleteChatPhoto(ctx context.Context, request models.DeleteChatPhoto) (bool, error)
+	SetChatTitle(ctx context.Context, request models.SetChatTitle) (bool, error)
+	SetChatDescription(ctx context.Context, request models.SetChatDescription) (bool, error)
+	PinChatMessage(ctx context.Context, request models.PinChatMessage) (bool, error)
+	UnpinChatMessage(ctx context.Context, request models.UnpinChatMessage) (bool, error)
+	LeaveChat(ctx context.Context, request models.LeaveChat) (bool, error)
+	GetChat(ctx context.Context, request models.GetChat) (models.Chat, error)
+	GetChatAdministrators(ctx context.Context, request models.GetChatAdministrators) ([]models.ChatMember, error)
+	GetChatMembersCount(ctx context.Context, request models.GetChatMembersCount) (int, error)
+	GetChatMember(ctx context.Context, request models.GetChatMember) (models.ChatMember, error)
+	SetChatStickerSet(ctx context.Context, request models.SetChatStickerSet) (bool, error)
+	DeleteChatStickerSet(ctx context.Context, request models.DeleteChatStickerSet) (bool, error)
+	AnswerCallbackQuery(ctx context.Context, request models.AnswerCallbackQuery) (bool, error)
+	EditMessageText(ctx context.Context, request models.EditMessageText) (models.MessageOrTrue, error)
+	EditMessageCaption(ctx context.Context, request models.EditMessageCaption) (models.MessageOrTrue, error)
+	EditMessageMedia(ctx context.Context, request models.EditMessageMedia) (models.MessageOrTrue, error)
+	EditMessageReplyMarkup(ctx context.Context, request models.EditMessageReplyMarkup) (models.MessageOrTrue, error)
+	StopPoll(ctx context.Context, request models.StopPoll) (models.Poll, error)
+	DeleteMessage(ctx context.Context, request models.DeleteMessage) (bool, error)
+	SendSticker(ctx context.Context, request models.SendSticker) (models.Message, error)
+	GetStickerSet(ctx context.Context, request models.GetStickerSet) (models.StickerSet, error)
+	UploadStickerFile(ctx context.Context, request models.UploadStickerFile) (models.File, error)
+	CreateNewStickerSet(ctx context.Context, request models.CreateNewStickerSet) (bool, error)
+	AddStickerToSet(ctx context.Context, request models.AddStickerToSet) (bool, error)
+	SetStickerPositionInSet(ctx context.Context, request models.SetStickerPositionInSet) (bool, error)
+	DeleteStickerFromSet(ctx context.Context, request models.DeleteStickerFromSet) (bool, error)
+	AnswerInlineQuery(ctx context.Context, request models.AnswerInlineQuery) (bool, error)
+	SendInvoice(ctx context.Context, request models.SendInvoice) (models.Message, error)
+	AnswerShippingQuery(ctx context.Context, request models.AnswerShippingQuery) (bool, error)
+	AnswerPreCheckoutQuery(ctx context.Context, request models.AnswerPreCheckoutQuery) (bool, error)
+	SetPassportDataErrors(ctx context.Context, request models.SetPassportDataErrors) (bool, error)
+	SendGame(ctx context.Context, request models.SendGame) (models.Message, error)
+	SetGameScore(ctx context.Context, request models.SetGameScore) (models.MessageOrTrue, error)
+	GetGameHighScores(ctx context.Context, request models.GetGameHighScores) ([]models.GameHighScore, error)
+	CopyMessage(ctx context.Context, request models.CopyMessage) (models.MessageId, error)
+	SendDice(ctx context.Context, request models.SendDice) (models.Message, error)
+	BanChatMember(ctx context.Context, request models.BanChatMember) (bool, error)
+	CreateChatInviteLink(ctx context.Context, request models.CreateChatInviteLink) (models.ChatInviteLink, error)
+	EditChatInviteLink(ctx context.Context, request models.EditChatInviteLink) (models.ChatInviteLink, error)
+	RevokeChatInviteLink(ctx context.Context, request models.RevokeChatInviteLink) (models.ChatInviteLink, error)
+	UnpinAllChatMessages(ctx context.Context, request models.UnpinAllChatMessages) (bool, error)
+	GetChatMemberCount(ctx context.Context, request models.GetChatMemberCount) (int, error)
+	SetMyCommands(ctx context.Context, request models.SetMyCommands) (bool, error)
+	DeleteMyCommands(ctx context.Context, request models.DeleteMyCommands) (bool, error)
+	GetMyCommands(ctx context.Context, request models.GetMyCommands) ([]models.BotCommand, error)
+	SetStickerSetThumb(ctx context.Context, request models.SetStickerSetThumb) (bool, error)
+}
@@ -2,13 +2,14 @@ package interfaces
 
 import (
 	models2 "bitbucket.org/y4cxp543/telegram-bot/telegram/models"
+	"context"
 )
 
+//ITelegramFunctions generated Bot API methods plus the ones implemented by hand
 type ITelegramFunctions interface {
-	GetMe() (models2.User, error)
-	GetUpdates(query models2.GetUpdates, response chan []models2.Update)
-	SendMessage(request models2.SendMessage) (models2.Message, error)
-	SendPoll(poll models2.SendPoll) (models2.Message, error)
-	GetFile(fileId string) (models2.File, error)
-	DownloadFile(filePath string) []byte
+	IBotAPI
+	GetUpdates(ctx context.Context, query models2.GetUpdates) ([]models2.Update, error)
+	DownloadFile(ctx context.Context, filePath string) ([]byte, error)
+	//FileLimits sizes of files the server accepts
+	FileLimits() models2.FileLimits
 }
@@ -2,22 +2,36 @@ package main
 
 import (
 	"bitbucket.org/y4cxp543/telegram-bot/global_services"
+	"context"
+	"log"
 	"os"
 	"os/signal"
+	"syscall"
+	"time"
 )
 
+const defaultShutdownTimeout = 30 * time.Second
+
 func main() {
+	var ctx, stop = signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+	if err := global_services.GlobalServicesStart(); err != nil {
+		shutdown()
+		log.Fatal(err)
+	}
+	global_services.TelegramBot.Start(ctx)
+	//The second signal kills the process at once
+	stop()
+	shutdown()
+}
 
-	c := make(chan os.Signal)
-	signal.Notify(c, os.Interrupt)
-	signal.Notify(c, os.Kill)
-	go func() {
-		<-c
-		global_services.GlobalServicesStop()
-		os.Exit(1)
-	}()
-	global_services.TelegramBot.RegisterBotCommand(global_services.CommandProcessor.ProcessSearchTorrents, "processSearchTorrents")
-	global_services.TelegramBot.RegisterBotCommand(global_services.CommandProcessor.ProcessDocument, "processDocument")
-	global_services.TelegramBot.RegisterBotCommand(global_services.CommandProcessor.ProcessMagnetLink, "processMagnetLink")
-	global_services.TelegramBot.Start()
-}
\ No newline at end of file
+//shutdown stops services in order, giving them ShutdownTimeout from [Client] configuration
+func shutdown() {
+	var timeout = time.Duration(global_services.Config.Client.ShutdownTimeout) * time.Second
+	if timeout <= 0 {
+		timeout = defaultShutdownTimeout
+	}
+	var ctx, cancel = context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+	global_services.GlobalServicesStop(ctx)
+}
@@ -8,11 +8,64 @@ import (
 	"strings"
 )
 
+//PublicApiServer Bot API server run by Telegram
+const PublicApiServer = "https://api.telegram.org"
+
 //Client Client
 type Client struct {
-	Token       string
+	Token string
+	//ApiServer url of Bot API server, PublicApiServer if empty. RequestURL and RequestFile are built from it if they are empty
+	ApiServer string
+	//LocalMode the self-hosted server runs with --local: files up to 2000 MB are uploaded and getFile returns paths on its disk.
+	//The bot must see the files under the same paths. It is also detected when getFile returns such path
+	LocalMode bool
+	//ServerFile keeps the server the bot used last, so moving to another server logs the bot out of the previous one
+	ServerFile  string
 	RequestURL  string
 	RequestFile string
+	//OffsetFile keeps the last handled update_id between restarts. In-memory offset is used if empty
+	OffsetFile string
+	//ConversationFile keeps unfinished dialogs between restarts. Dialogs are kept in memory if empty
+	ConversationFile string
+	//ConversationTimeout seconds the bot waits for the answer in a dialog
+	ConversationTimeout int
+	//RequestTimeout seconds every Bot API call may take, uploads are not limited
+	RequestTimeout int
+	//ShutdownTimeout seconds to finish handling updates, send queued messages and stop aria2 on exit
+	ShutdownTimeout int
+	//Mode update delivery mode: "polling" (default) or "webhook"
+	Mode       string
+	Webhook    Webhook
+	Dispatcher Dispatcher
+}
+
+//Dispatcher handles updates of different chats in parallel and updates of one chat in order
+type Dispatcher struct {
+	//Workers handle updates concurrently, 0 uses the default pool size
+	Workers int
+	//QueueSize updates waiting for workers. Receiving updates pauses while the queue is full
+	QueueSize int
+	//OrderBy "chat" (default) or "user": updates with the same chat or user are handled one by one
+	OrderBy string
+	//StatsInterval seconds between logged queue metrics, 0 disables them
+	StatsInterval int
+}
+
+//Webhook settings of the embedded server receiving updates in webhook mode
+type Webhook struct {
+	//Url public HTTPS url Telegram posts updates to (usually the reverse proxy address)
+	Url string
+	//Listen address of the embedded server, e.g. ":8080"
+	Listen string
+	//Path handled by the embedded server, e.g. "/telegram/updates"
+	Path string
+	//SecretToken sent by Telegram in X-Telegram-Bot-Api-Secret-Token header
+	SecretToken string
+	//CertFile and KeyFile enable HTTPS on the embedded server. Leave empty behind a TLS terminating proxy
+	CertFile           string
+	KeyFile            string
+	MaxConnections     int
+	DropPendingUpdates bool
 }
 
 type Aria2C struct {
@@ -24,6 +77,35 @@ type Aria2C struct {
 	MaxConnectionsPerServer int
 	MaxConcurrentDownloads  int
 	LogLevel                string
+	//StatusInterval seconds between progress requests of a download, its status message is edited no more often
+	StatusInterval int
+}
+
+//Access who may use the bot. Everyone else gets DefaultRole
+type Access struct {
+	//Admins user ids with admin role, they issue invite codes and approve new users
+	Admins []int64
+	//Users user ids with user role
+	Users []int64
+	//Chats chat ids whose members get user role
+	Chats []int64
+	//DefaultRole "guest" (default) may only read /help and ask for access, "user" opens the bot to everyone
+	DefaultRole string
+	//ReportChat chat id where unauthorized attempts are reported, they are only logged if empty.
+	//Access requests go there too, or to every admin if empty
+	ReportChat int64
+	//UsersFile keeps users approved from Telegram between restarts. Approved users are kept in memory if empty
+	UsersFile string
+}
+
+//Inline search of torrents by "@bot words" in any chat. Inline mode must be enabled in @BotFather
+type Inline struct {
+	//Debounce milliseconds without new letters before the query is searched
+	Debounce int
+	//CacheTTL seconds results of a query are reused
+	CacheTTL int
+	//PageSize results per answer, the next page is asked when the list is scrolled. No more than 50
+	PageSize int
 }
 
 //Conf Conf
@@ -31,6 +113,8 @@ type Conf struct {
 	Title  string
 	Client Client
 	Aria2C Aria2C
+	Access Access
+	Inline Inline
 }
 
 //ConfigurationFile файл конфигурации
@@ -48,6 +132,16 @@ func ReadConfig() Conf {
 		log.Fatal("ERROR", err)
 	}
 
+	config.Client.ApiServer = strings.TrimRight(config.Client.ApiServer, "/")
+	if config.Client.ApiServer == "" {
+		config.Client.ApiServer = PublicApiServer
+	}
+	if config.Client.RequestURL == "" {
+		config.Client.RequestURL = config.Client.ApiServer + "/bot${token}/${method}"
+	}
+	if config.Client.RequestFile == "" {
+		config.Client.RequestFile = config.Client.ApiServer + "/file/bot${token}/${filePath}"
+	}
 	var url = strings.Replace(config.Client.RequestURL, "${token}", config.Client.Token, -1)
 	config.Client.RequestURL = url
 	var fileRequest = strings.Replace(config.Client.RequestFile, "${token}", config.Client.Token, -1)
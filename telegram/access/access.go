@@ -0,0 +1,399 @@
+package access
+
+import (
+	"bitbucket.org/y4cxp543/telegram-bot/constants"
+	"bitbucket.org/y4cxp543/telegram-bot/interfaces"
+	configuration "bitbucket.org/y4cxp543/telegram-bot/models"
+	"bitbucket.org/y4cxp543/telegram-bot/telegram/callback"
+	"bitbucket.org/y4cxp543/telegram-bot/telegram/command"
+	"bitbucket.org/y4cxp543/telegram-bot/telegram/middleware"
+	"bitbucket.org/y4cxp543/telegram-bot/telegram/models"
+	"bitbucket.org/y4cxp543/telegram-bot/telegram/registry"
+	"bitbucket.org/y4cxp543/telegram-bot/util"
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+//Prefix callback_data prefix of the buttons approving access requests
+const Prefix = "ac"
+
+const (
+	approveAction = "ok"
+	denyAction    = "no"
+)
+
+const (
+	//DefaultInviteTTL invite code can be used once within this time
+	DefaultInviteTTL = 24 * time.Hour
+	//ReportInterval the same user is reported and may ask for access at most once within this time
+	ReportInterval   = 10 * time.Minute
+	inviteCodeLength = 8
+)
+
+//Access resolves roles of users from the configuration and from the users approved in Telegram
+type Access struct {
+	mutex       sync.Mutex
+	admins      map[int64]bool
+	users       map[int64]bool
+	chats       map[int64]bool
+	granted     map[int64]constants.Role
+	defaultRole constants.Role
+	reportChat  int64
+	invites     map[string]time.Time
+	reported    map[string]time.Time
+	inviteTTL   time.Duration
+	store       interfaces.RoleStore
+	clock       interfaces.Clock
+	tFunctions  interfaces.ITelegramFunctions
+}
+
+//NewAccess loads the users approved earlier from the store. Unknown default role falls back to constants.RoleGuest
+func NewAccess(config configuration.Access, store interfaces.RoleStore, tFunctions interfaces.ITelegramFunctions, clock interfaces.Clock, inviteTTL time.Duration) *Access {
+	var defaultRole = constants.Role(config.DefaultRole)
+	if !defaultRole.IsKnown() {
+		if defaultRole != "" {
+			log.Println("Unknown default role, guest is used: ", config.DefaultRole)
+		}
+		defaultRole = constants.RoleGuest
+	}
+	if inviteTTL <= 0 {
+		inviteTTL = DefaultInviteTTL
+	}
+	var granted, err = store.LoadAll()
+	if err != nil {
+		log.Println("Approved users are not loaded: ", err)
+		granted = make(map[int64]constants.Role)
+	}
+	return &Access{
+		admins:      idSet(config.Admins),
+		users:       idSet(config.Users),
+		chats:       idSet(config.Chats),
+		granted:     granted,
+		defaultRole: defaultRole,
+		reportChat:  config.ReportChat,
+		invites:     make(map[string]time.Time),
+		reported:    make(map[string]time.Time),
+		inviteTTL:   inviteTTL,
+		store:       store,
+		clock:       clock,
+		tFunctions:  tFunctions,
+	}
+}
+
+func idSet(ids []int64) map[int64]bool {
+	var set = make(map[int64]bool, len(ids))
+	for _, id := range ids {
+		set[id] = true
+	}
+	return set
+}
+
+//RoleOf configured admins first, then users approved in Telegram, configured users and members of allowed chats
+func (access *Access) RoleOf(chatId, userId int64) constants.Role {
+	access.mutex.Lock()
+	defer access.mutex.Unlock()
+	switch {
+	case access.admins[userId]:
+		return constants.RoleAdmin
+	case access.granted[userId] != "":
+		return access.granted[userId]
+	case access.users[userId], access.chats[chatId]:
+		return constants.RoleUser
+	}
+	return access.defaultRole
+}
+
+//Resolver roles of command senders for the registry
+func (access *Access) Resolver() registry.RoleResolver {
+	return func(arg interfaces.BotCommandArgument) constants.Role {
+		return access.RoleOf(arg.ChatId, arg.UserId)
+	}
+}
+
+//MenuRoles the default role for the menu of everyone, admins and users get menus of their private chats
+//and allowed chats get the menu of users. Users approved later see their commands after restart
+func (access *Access) MenuRoles() registry.MenuRoles {
+	return func() (constants.Role, map[int64]constants.Role) {
+		access.mutex.Lock()
+		defer access.mutex.Unlock()
+		var chats = make(map[int64]constants.Role)
+		for chatId := range access.chats {
+			chats[chatId] = constants.RoleUser
+		}
+		for userId := range access.users {
+			chats[userId] = constants.RoleUser
+		}
+		for userId, role := range access.granted {
+			chats[userId] = role
+		}
+		for userId := range access.admins {
+			chats[userId] = constants.RoleAdmin
+		}
+		return access.defaultRole, chats
+	}
+}
+
+//Middleware lets only users press buttons and answer dialogs, commands are checked by the registry against their roles.
+//Every forbidden attempt is logged and reported to the report chat
+func (access *Access) Middleware() interfaces.IMiddleware {
+	return func(next interfaces.IHandler) interfaces.IHandler {
+		return func(request *interfaces.HandlerRequest) error {
+			var err error
+			if request.Kind != interfaces.CommandHandler && !access.RoleOf(request.ChatId, request.UserId).Allows(constants.RoleUser) {
+				err = middleware.ErrForbidden
+			} else {
+				err = next(request)
+			}
+			if errors.Is(err, middleware.ErrForbidden) {
+				access.report(request)
+			}
+			return err
+		}
+	}
+}
+
+func (access *Access) report(request *interfaces.HandlerRequest) {
+	log.Printf("Unauthorized %s %s from user %d in chat %d", request.Kind, request.Name, request.UserId, request.ChatId)
+	if access.reportChat == 0 || !access.allow("report", request.UserId) {
+		return
+	}
+	access.send(request.Context, access.reportChat, fmt.Sprintf("Unauthorized %s %s from user %d in chat %d",
+		request.Kind, request.Name, request.UserId, request.ChatId), nil)
+}
+
+//allow throttles reports and access requests of the user
+func (access *Access) allow(kind string, userId int64) bool {
+	access.mutex.Lock()
+	defer access.mutex.Unlock()
+	var key = kind + constants.Space + strconv.FormatInt(userId, 10)
+	var now = access.clock.Now()
+	if last, ok := access.reported[key]; ok && now.Sub(last) < ReportInterval {
+		return false
+	}
+	access.reported[key] = now
+	for old, last := range access.reported {
+		if now.Sub(last) >= ReportInterval {
+			delete(access.reported, old)
+		}
+	}
+	return true
+}
+
+//Grant saves the role of the user, empty role revokes the role granted earlier
+func (access *Access) Grant(userId int64, role constants.Role) error {
+	if err := access.store.Save(userId, role); err != nil {
+		return err
+	}
+	access.mutex.Lock()
+	defer access.mutex.Unlock()
+	if role == "" {
+		delete(access.granted, userId)
+	} else {
+		access.granted[userId] = role
+	}
+	return nil
+}
+
+//NewInvite one-time code granting constants.RoleUser
+func (access *Access) NewInvite() string {
+	access.mutex.Lock()
+	defer access.mutex.Unlock()
+	var now = access.clock.Now()
+	for code, expires := range access.invites {
+		if !now.Before(expires) {
+			delete(access.invites, code)
+		}
+	}
+	var code = util.Guid()[:inviteCodeLength]
+	access.invites[code] = now.Add(access.inviteTTL)
+	return code
+}
+
+//useInvite removes the code, returns false if it is unknown or expired
+func (access *Access) useInvite(code string) bool {
+	access.mutex.Lock()
+	defer access.mutex.Unlock()
+	var expires, ok = access.invites[code]
+	delete(access.invites, code)
+	return ok && access.clock.Now().Before(expires)
+}
+
+//Commands /invite for admins and /join for everyone
+func (access *Access) Commands() []registry.Command {
+	return []registry.Command{
+		{
+			Schema: command.Schema{
+				Name:        string(constants.Invite),
+				Description: "Create one-time invite code",
+			},
+			Descriptions: map[string]string{"ru": "Создать одноразовый код приглашения"},
+			Role:         constants.RoleAdmin,
+			Handler:      access.invite,
+		},
+		{
+			Schema: command.Schema{
+				Name:        string(constants.Join),
+				Description: "Get access by invite code or ask admins for it",
+				Args:        []command.Arg{{Name: "code", Description: "Invite code from an admin"}},
+			},
+			Descriptions: map[string]string{"ru": "Получить доступ по коду приглашения или запросить его у администраторов"},
+			Role:         constants.RoleGuest,
+			Handler:      access.join,
+		},
+	}
+}
+
+func (access *Access) invite(arg interfaces.BotCommandArgument) {
+	var code = access.NewInvite()
+	access.reply(arg, fmt.Sprintf("Invite code: %s\nIt works once within %s, the user sends /%s %s",
+		code, access.inviteTTL, constants.Join, code))
+}
+
+func (access *Access) join(arg interfaces.BotCommandArgument) {
+	if access.RoleOf(arg.ChatId, arg.UserId).Allows(constants.RoleUser) {
+		access.reply(arg, "You already have access, see /"+string(constants.Help))
+		return
+	}
+	var name = userName(arg)
+	if code := arg.Args.String("code"); code != constants.EmptyString {
+		if !access.useInvite(code) {
+			access.reply(arg, "The invite code is wrong or expired")
+			return
+		}
+		if err := access.Grant(arg.UserId, constants.RoleUser); err != nil {
+			log.Println("Access is not granted to ", arg.UserId, ": ", err)
+			access.reply(arg, "Something went wrong, try again later")
+			return
+		}
+		log.Printf("User %d joined by invite code", arg.UserId)
+		if access.reportChat != 0 {
+			access.send(arg.Context, access.reportChat, name+" joined by invite code", nil)
+		}
+		access.reply(arg, "Access granted, see /"+string(constants.Help))
+		return
+	}
+	if !access.allow("join", arg.UserId) {
+		access.reply(arg, "Your request is already sent, wait for admins to answer")
+		return
+	}
+	var userId = strconv.FormatInt(arg.UserId, 10)
+	var approve, _ = callback.Encode(Prefix, approveAction, userId)
+	var deny, _ = callback.Encode(Prefix, denyAction, userId)
+	var markup = &models.InlineKeyboardMarkup{InlineKeyboard: [][]models.InlineKeyboardButton{{
+		{Text: "Approve", CallbackData: approve},
+		{Text: "Deny", CallbackData: deny},
+	}}}
+	var sent = false
+	for _, chatId := range access.requestChats() {
+		sent = access.send(arg.Context, chatId, name+" asks for access", markup) || sent
+	}
+	if !sent {
+		access.reply(arg, "Nobody can approve the request, ask the owner of the bot for an invite code")
+		return
+	}
+	access.reply(arg, "Your request is sent to admins")
+}
+
+//requestChats the report chat or private chats of every admin
+func (access *Access) requestChats() []int64 {
+	if access.reportChat != 0 {
+		return []int64{access.reportChat}
+	}
+	access.mutex.Lock()
+	defer access.mutex.Unlock()
+	var chats = make([]int64, 0, len(access.admins))
+	for admin := range access.admins {
+		chats = append(chats, admin)
+	}
+	return chats
+}
+
+//HandleCallback approves or denies the access request, only admins may press the buttons
+func (access *Access) HandleCallback(arg interfaces.CallbackArgument) (string, error) {
+	if len(arg.Args) != 2 || arg.Query == nil || arg.Query.From == nil {
+		return constants.EmptyString, callback.ErrExpired
+	}
+	if access.RoleOf(arg.ChatId, arg.Query.From.Id) != constants.RoleAdmin {
+		return constants.EmptyString, middleware.ErrForbidden
+	}
+	var userId, err = strconv.ParseInt(arg.Args[1], 10, 64)
+	if err != nil {
+		return constants.EmptyString, callback.ErrExpired
+	}
+	var answer, verdict string
+	switch arg.Args[0] {
+	case approveAction:
+		if err = access.Grant(userId, constants.RoleUser); err != nil {
+			return constants.EmptyString, err
+		}
+		answer, verdict = "Approved", "Your access request is approved, see /"+string(constants.Help)
+	case denyAction:
+		answer, verdict = "Denied", "Your access request is denied"
+	default:
+		return constants.EmptyString, callback.ErrExpired
+	}
+	log.Printf("Access request of user %d: %s by %d", userId, answer, arg.Query.From.Id)
+	access.send(arg.Context, userId, verdict, nil)
+	access.closeRequest(arg, answer)
+	return answer, nil
+}
+
+//closeRequest removes the buttons, so other admins see the request is answered
+func (access *Access) closeRequest(arg interfaces.CallbackArgument, answer string) {
+	if arg.Query.Message == nil {
+		return
+	}
+	var chatId = models.NewChatID(arg.ChatId)
+	var text = arg.Query.Message.Text + "\n" + answer + " by " + describe(arg.Query.From)
+	if _, err := access.tFunctions.EditMessageText(arg.Context, models.EditMessageText{
+		ChatId:    &chatId,
+		MessageId: models.Int(arg.MessageId),
+		Text:      text,
+	}); err != nil {
+		log.Println(err)
+	}
+}
+
+func (access *Access) reply(arg interfaces.BotCommandArgument, text string) {
+	if _, err := access.tFunctions.SendMessage(arg.Context, models.SendMessage{
+		ChatId:           models.NewChatID(arg.ChatId),
+		Text:             text,
+		ReplyToMessageId: models.Int(arg.MessageId),
+	}); err != nil {
+		log.Println(err)
+	}
+}
+
+func (access *Access) send(ctx context.Context, chatId int64, text string, markup *models.InlineKeyboardMarkup) bool {
+	var request = models.SendMessage{ChatId: models.NewChatID(chatId), Text: text}
+	if markup != nil {
+		request.ReplyMarkup = markup
+	}
+	if _, err := access.tFunctions.SendMessage(ctx, request); err != nil {
+		log.Println("Message to chat ", chatId, " is not sent: ", err)
+		return false
+	}
+	return true
+}
+
+func userName(arg interfaces.BotCommandArgument) string {
+	if arg.Response != nil && arg.Response.Message != nil && arg.Response.Message.From != nil {
+		return describe(arg.Response.Message.From)
+	}
+	return "User " + strconv.FormatInt(arg.UserId, 10)
+}
+
+//describe name, username and id, so admins can tell users apart
+func describe(user *models.User) string {
+	var name = strings.TrimSpace(user.FirstName + constants.Space + user.LastName)
+	if user.Username != constants.EmptyString {
+		name += " @" + user.Username
+	}
+	return fmt.Sprintf("%s (%d)", name, user.Id)
+}
@@ -0,0 +1,38 @@
+package callback
+
+import (
+	"errors"
+	"strings"
+)
+
+//MaxDataSize Telegram limits callback_data of a button to 64 bytes
+const MaxDataSize = 64
+
+//Separator splits prefix and arguments of callback_data: "prefix:arg1:arg2"
+const Separator = ":"
+
+var ErrDataTooLong = errors.New("callback data is longer than 64 bytes")
+
+//Encode builds callback_data handled by the router registered for the prefix.
+//Arguments must not contain Separator, long values should be kept in cache and referenced by a short key
+func Encode(prefix string, args ...string) (string, error) {
+	if prefix == "" || strings.Contains(prefix, Separator) {
+		return "", errors.New("callback prefix must be non empty and must not contain " + Separator)
+	}
+	for _, arg := range args {
+		if strings.Contains(arg, Separator) {
+			return "", errors.New("callback argument must not contain " + Separator + ": " + arg)
+		}
+	}
+	var data = strings.Join(append([]string{prefix}, args...), Separator)
+	if len(data) > MaxDataSize {
+		return "", ErrDataTooLong
+	}
+	return data, nil
+}
+
+//Decode splits callback_data built by Encode
+func Decode(data string) (string, []string) {
+	var parts = strings.Split(data, Separator)
+	return parts[0], parts[1:]
+}
@@ -0,0 +1,122 @@
+package callback
+
+import (
+	"bitbucket.org/y4cxp543/telegram-bot/interfaces"
+	"bitbucket.org/y4cxp543/telegram-bot/telegram/middleware"
+	"bitbucket.org/y4cxp543/telegram-bot/telegram/models"
+	"context"
+	"errors"
+	"log"
+	"strings"
+	"sync"
+)
+
+const (
+	expiredText   = "This button has expired"
+	unknownText   = "Unknown button"
+	failedText    = "Something went wrong"
+	forbiddenText = "You are not allowed to press this button"
+)
+
+//ErrExpired handler returns it when the state the button refers to is gone, e.g. removed from cache
+var ErrExpired = errors.New("callback data expired")
+
+//Router calls handler registered for the prefix of callback_data and always answers the query,
+//so the client stops showing the progress bar on the button
+type Router struct {
+	mutex      sync.RWMutex
+	handlers   map[string]interfaces.ICallbackFunc
+	chain      *middleware.Chain
+	tFunctions interfaces.ITelegramFunctions
+}
+
+func NewRouter(tFunctions interfaces.ITelegramFunctions, chain *middleware.Chain) *Router {
+	return &Router{
+		handlers:   make(map[string]interfaces.ICallbackFunc),
+		chain:      chain,
+		tFunctions: tFunctions,
+	}
+}
+
+//Handle registers handler for callback_data built by Encode with the prefix, the last registration wins
+func (router *Router) Handle(prefix string, handler interfaces.ICallbackFunc) {
+	if prefix == "" || strings.Contains(prefix, Separator) {
+		log.Println("Wrong callback prefix: ", prefix)
+		return
+	}
+	router.mutex.Lock()
+	defer router.mutex.Unlock()
+	router.handlers[prefix] = handler
+}
+
+func (router *Router) Route(ctx context.Context, query *models.CallbackQuery) {
+	if query == nil {
+		return
+	}
+	var prefix, args = Decode(query.Data)
+	router.mutex.RLock()
+	var handler, ok = router.handlers[prefix]
+	router.mutex.RUnlock()
+	if !ok {
+		//Buttons of older bot versions or forged data
+		log.Println("Unknown callback data: ", query.Data)
+		router.answer(ctx, query, unknownText, true)
+		return
+	}
+	var arg = newCallbackArgument(ctx, prefix, args, query)
+	var request = &interfaces.HandlerRequest{
+		Context:   arg.Context,
+		Kind:      interfaces.CallbackHandler,
+		Name:      prefix,
+		ChatId:    arg.ChatId,
+		MessageId: arg.MessageId,
+	}
+	if query.From != nil {
+		request.UserId = query.From.Id
+	}
+	var text string
+	var err = router.chain.Run(request, func(*interfaces.HandlerRequest) error {
+		var handlerErr error
+		text, handlerErr = handler(arg)
+		return handlerErr
+	})
+	switch {
+	case errors.Is(err, ErrExpired):
+		router.answer(ctx, query, expiredText, true)
+	case errors.Is(err, middleware.ErrForbidden):
+		router.answer(ctx, query, forbiddenText, true)
+	case err != nil:
+		log.Println("Callback "+query.Data+" failed: ", err)
+		router.answer(ctx, query, failedText, true)
+	default:
+		router.answer(ctx, query, text, false)
+	}
+}
+
+func (router *Router) answer(ctx context.Context, query *models.CallbackQuery, text string, showAlert bool) {
+	var request = models.AnswerCallbackQuery{CallbackQueryId: query.Id, Text: text}
+	if showAlert {
+		request.ShowAlert = models.Bool(true)
+	}
+	if _, err := router.tFunctions.AnswerCallbackQuery(ctx, request); err != nil {
+		log.Println(err)
+	}
+}
+
+func newCallbackArgument(ctx context.Context, prefix string, args []string, query *models.CallbackQuery) interfaces.CallbackArgument {
+	var arg = interfaces.CallbackArgument{
+		Context:         ctx,
+		Prefix:          prefix,
+		Args:            args,
+		InlineMessageId: query.InlineMessageId,
+		Query:           query,
+	}
+	//Message is missing for buttons of inline messages
+	if query.Message != nil {
+		arg.MessageId = query.Message.MessageId
+		if query.Message.Chat != nil {
+			arg.ChatId = query.Message.Chat.Id
+		}
+	}
+	return arg
+}
@@ -0,0 +1,53 @@
+package command
+
+import (
+	"strconv"
+)
+
+//Arguments typed values of arguments and flags, missing optional ones read as zero values or flag defaults
+type Arguments struct {
+	values map[string]interface{}
+}
+
+func (args Arguments) Has(name string) bool {
+	var _, ok = args.values[name]
+	return ok
+}
+
+func (args Arguments) String(name string) string {
+	var value, _ = args.values[name].(string)
+	return value
+}
+
+func (args Arguments) Int(name string) int {
+	var value, _ = args.values[name].(int)
+	return value
+}
+
+func (args Arguments) Bool(name string) bool {
+	var value, _ = args.values[name].(bool)
+	return value
+}
+
+func (args *Arguments) set(schema Schema, name string, argType ArgType, text string) error {
+	if args.values == nil {
+		args.values = make(map[string]interface{})
+	}
+	switch argType {
+	case Int:
+		var value, err = strconv.Atoi(text)
+		if err != nil {
+			return &UsageError{Schema: schema, Message: name + " must be a number: " + text}
+		}
+		args.values[name] = value
+	case Bool:
+		var value, err = strconv.ParseBool(text)
+		if err != nil {
+			return &UsageError{Schema: schema, Message: name + " must be true or false: " + text}
+		}
+		args.values[name] = value
+	default:
+		args.values[name] = text
+	}
+	return nil
+}
@@ -0,0 +1,191 @@
+package command
+
+import (
+	"bitbucket.org/y4cxp543/telegram-bot/telegram/models"
+	"strings"
+	"sync"
+	"unicode/utf16"
+)
+
+const botCommandEntity = "bot_command"
+const flagPrefix = "--"
+
+//Command parsed bot command. RawArgs is the text after the command, Args are filled when the command has a schema
+type Command struct {
+	Name    string
+	RawArgs string
+	Args    Arguments
+}
+
+//Parser finds bot commands by entities and validates their arguments against registered schemas
+type Parser struct {
+	mutex    sync.RWMutex
+	username string
+	schemas  map[string]Schema
+}
+
+func NewParser() *Parser {
+	return &Parser{schemas: make(map[string]Schema)}
+}
+
+//SetUsername username of the bot from getMe. Commands addressed to other bots (/search@OtherBot) are skipped
+func (parser *Parser) SetUsername(username string) {
+	parser.mutex.Lock()
+	defer parser.mutex.Unlock()
+	parser.username = strings.TrimPrefix(username, "@")
+}
+
+func (parser *Parser) Register(schemas ...Schema) {
+	parser.mutex.Lock()
+	defer parser.mutex.Unlock()
+	for _, schema := range schemas {
+		parser.schemas[strings.ToLower(schema.Name)] = schema
+	}
+}
+
+func (parser *Parser) Schema(name string) (Schema, bool) {
+	parser.mutex.RLock()
+	defer parser.mutex.RUnlock()
+	var schema, ok = parser.schemas[strings.ToLower(name)]
+	return schema, ok
+}
+
+//Parse returns the first bot command of the text addressed to this bot, nil if there is none.
+//Returns *UsageError if arguments do not match the schema of the command
+func (parser *Parser) Parse(text string, entities []models.MessageEntity) (*Command, error) {
+	var encoded = utf16.Encode([]rune(text))
+	for _, entity := range entities {
+		if entity.Type != botCommandEntity || entity.Offset < 0 || entity.Length < 2 || entity.Offset+entity.Length > len(encoded) {
+			continue
+		}
+		var name = string(utf16.Decode(encoded[entity.Offset+1 : entity.Offset+entity.Length]))
+		if at := strings.Index(name, "@"); at >= 0 {
+			if !parser.addressedToMe(name[at+1:]) {
+				continue
+			}
+			name = name[:at]
+		}
+		var command = &Command{
+			Name:    name,
+			RawArgs: strings.TrimSpace(string(utf16.Decode(encoded[entity.Offset+entity.Length:]))),
+		}
+		if schema, ok := parser.Schema(name); ok {
+			var args, err = parseArguments(schema, command.RawArgs)
+			if err != nil {
+				return command, err
+			}
+			command.Args = args
+		}
+		return command, nil
+	}
+	return nil, nil
+}
+
+//HasCommand the text has a bot command, maybe addressed to another bot
+func HasCommand(entities []models.MessageEntity) bool {
+	for _, entity := range entities {
+		if entity.Type == botCommandEntity {
+			return true
+		}
+	}
+	return false
+}
+
+//addressedToMe when the bot does not know its username yet every command is accepted
+func (parser *Parser) addressedToMe(username string) bool {
+	parser.mutex.RLock()
+	defer parser.mutex.RUnlock()
+	return parser.username == "" || strings.EqualFold(parser.username, username)
+}
+
+func parseArguments(schema Schema, text string) (Arguments, error) {
+	var args Arguments
+	var tokens, err = tokenize(text)
+	if err != nil {
+		return args, &UsageError{Schema: schema, Message: err.Error()}
+	}
+	var positional []token
+	var flagsEnded bool
+	for index := 0; index < len(tokens); index++ {
+		var word = tokens[index]
+		if flagsEnded || word.quoted || !strings.HasPrefix(word.text, flagPrefix) {
+			positional = append(positional, word)
+			continue
+		}
+		if word.text == flagPrefix {
+			flagsEnded = true
+			continue
+		}
+		var name, value = strings.TrimPrefix(word.text, flagPrefix), ""
+		var hasValue bool
+		if eq := strings.Index(name, "="); eq >= 0 {
+			name, value, hasValue = name[:eq], name[eq+1:], true
+		}
+		var flag, known = schema.flag(name)
+		if !known {
+			return args, &UsageError{Schema: schema, Message: "Unknown flag --" + name}
+		}
+		if !hasValue {
+			if flag.Type == Bool {
+				value = "true"
+			} else if index+1 < len(tokens) {
+				index++
+				value = tokens[index].text
+			} else {
+				return args, &UsageError{Schema: schema, Message: "Flag --" + name + " needs a value"}
+			}
+		}
+		if err := args.set(schema, name, flag.Type, value); err != nil {
+			return args, err
+		}
+	}
+	for _, flag := range schema.Flags {
+		if !args.Has(flag.Name) && flag.Default != "" {
+			if err := args.set(schema, flag.Name, flag.Type, flag.Default); err != nil {
+				return args, err
+			}
+		}
+	}
+	for _, arg := range schema.Args {
+		if len(positional) == 0 {
+			if arg.Required {
+				return args, &UsageError{Schema: schema, Message: "Missing " + arg.Name}
+			}
+			continue
+		}
+		var value = positional[0].text
+		if arg.Rest {
+			value = rest(text, positional)
+			positional = nil
+		} else {
+			positional = positional[1:]
+		}
+		if err := args.set(schema, arg.Name, arg.Type, value); err != nil {
+			return args, err
+		}
+	}
+	if len(positional) > 0 {
+		return args, &UsageError{Schema: schema, Message: "Too many arguments: " + rest(text, positional)}
+	}
+	return args, nil
+}
+
+//rest remaining words as they were typed, with their quotes and backslashes. A single word is used without its quotes.
+//Spaces between the words are kept, a flag between them is replaced with one space
+func rest(text string, words []token) string {
+	if len(words) == 1 {
+		return words[0].text
+	}
+	var builder = new(strings.Builder)
+	for index, word := range words {
+		if index > 0 {
+			var between = text[words[index-1].end:word.start]
+			if strings.TrimSpace(between) != "" {
+				between = " "
+			}
+			builder.WriteString(between)
+		}
+		builder.WriteString(text[word.start:word.end])
+	}
+	return builder.String()
+}
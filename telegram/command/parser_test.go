@@ -0,0 +1,111 @@
+package command
+
+import (
+	"bitbucket.org/y4cxp543/telegram-bot/telegram/models"
+	"strings"
+	"testing"
+)
+
+var search = Schema{
+	Name:  "search",
+	Args:  []Arg{{Name: "query", Rest: true}},
+	Flags: []Flag{{Name: "limit", Type: Int}, {Name: "all", Type: Bool}},
+}
+
+var save = Schema{
+	Name: "save",
+	Args: []Arg{{Name: "dir", Required: true}},
+}
+
+//parse the text starting with the command as Telegram sends it: the command is marked by the bot_command entity
+func parse(t *testing.T, text string) (*Command, error) {
+	var parser = NewParser()
+	parser.SetUsername("TorrentBot")
+	parser.Register(search, save)
+	var name = strings.Fields(text)[0]
+	return parser.Parse(text, []models.MessageEntity{{Type: botCommandEntity, Offset: 0, Length: len(name)}})
+}
+
+func TestArguments(t *testing.T) {
+	var tests = []struct {
+		text  string
+		query string
+		limit int
+	}{
+		{text: "/search ocean's eleven", query: "ocean's eleven"},
+		{text: "/search don't stop --limit 3", query: "don't stop", limit: 3},
+		{text: "/search@TorrentBot rock'n'roll", query: "rock'n'roll"},
+		{text: "/search --limit=5 'ocean eleven'", query: "ocean eleven", limit: 5},
+		{text: "/search \"ocean's  eleven\"", query: "ocean's  eleven"},
+		{text: "/search «ocean eleven»", query: "ocean eleven"},
+		{text: "/search ocean  eleven   2001", query: "ocean  eleven   2001"},
+		{text: "/search don't --all stop", query: "don't stop"},
+		{text: "/search \"ocean eleven\" 2001", query: "\"ocean eleven\" 2001"},
+		{text: "/search ocean\\ eleven", query: "ocean eleven"},
+		{text: "/search -- --limit 3", query: "--limit 3"},
+	}
+	for _, test := range tests {
+		var parsed, err = parse(t, test.text)
+		if err != nil {
+			t.Errorf("%s: %v", test.text, err)
+			continue
+		}
+		if query := parsed.Args.String("query"); query != test.query {
+			t.Errorf("%s: query %q, expected %q", test.text, query, test.query)
+		}
+		if limit := parsed.Args.Int("limit"); limit != test.limit {
+			t.Errorf("%s: limit %d, expected %d", test.text, limit, test.limit)
+		}
+	}
+}
+
+func TestBackslashes(t *testing.T) {
+	var tests = []struct {
+		text string
+		dir  string
+	}{
+		{text: "/save C:\\Games", dir: "C:\\Games"},
+		{text: "/save C:\\Games\\", dir: "C:\\Games\\"},
+		{text: "/save \"C:\\Program Files\"", dir: "C:\\Program Files"},
+		{text: "/save 'C:\\Games\\'", dir: "C:\\Games\\"},
+		{text: "/save My\\ Games", dir: "My Games"},
+		{text: "/save \"say \\\"hi\\\"\"", dir: "say \"hi\""},
+		{text: "/save \\\\server\\share", dir: "\\server\\share"},
+		{text: "/save \\'quoted\\'", dir: "'quoted'"},
+	}
+	for _, test := range tests {
+		var parsed, err = parse(t, test.text)
+		if err != nil {
+			t.Errorf("%s: %v", test.text, err)
+			continue
+		}
+		if dir := parsed.Args.String("dir"); dir != test.dir {
+			t.Errorf("%s: dir %q, expected %q", test.text, dir, test.dir)
+		}
+	}
+}
+
+func TestUsageErrors(t *testing.T) {
+	var tests = []struct {
+		text    string
+		message string
+	}{
+		{text: "/search 'ocean eleven", message: "quote is not closed"},
+		{text: "/search ocean --limit many", message: "limit must be a number: many"},
+		{text: "/search ocean --year 2001", message: "Unknown flag --year"},
+		{text: "/search ocean --limit", message: "Flag --limit needs a value"},
+		{text: "/save", message: "Missing dir"},
+		{text: "/save C:\\Games D:\\Games", message: "Too many arguments: D:\\Games"},
+	}
+	for _, test := range tests {
+		var _, err = parse(t, test.text)
+		var usage, ok = err.(*UsageError)
+		if !ok {
+			t.Errorf("%s: usage error expected, got %v", test.text, err)
+			continue
+		}
+		if usage.Message != test.message {
+			t.Errorf("%s: %q, expected %q", test.text, usage.Message, test.message)
+		}
+	}
+}
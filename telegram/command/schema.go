@@ -0,0 +1,93 @@
+package command
+
+import (
+	"strings"
+)
+
+//ArgType type the argument value is converted to
+type ArgType int
+
+const (
+	String ArgType = iota
+	Int
+	Bool
+)
+
+func (t ArgType) String() string {
+	switch t {
+	case Int:
+		return "number"
+	case Bool:
+		return "flag"
+	}
+	return "text"
+}
+
+//Arg positional argument. Rest argument takes all remaining words, so it must be the last one
+type Arg struct {
+	Name        string
+	Type        ArgType
+	Required    bool
+	Rest        bool
+	Description string
+}
+
+//Flag named argument: --name value, --name=value, or just --name for Bool flags
+type Flag struct {
+	Name        string
+	Type        ArgType
+	Default     string
+	Description string
+}
+
+//Schema arguments of one command
+type Schema struct {
+	Name        string
+	Description string
+	Args        []Arg
+	Flags       []Flag
+}
+
+func (schema Schema) flag(name string) (Flag, bool) {
+	for _, flag := range schema.Flags {
+		if flag.Name == name {
+			return flag, true
+		}
+	}
+	return Flag{}, false
+}
+
+//Usage one line synopsis of the command, e.g. /search <query...> [--limit number]
+func (schema Schema) Usage() string {
+	var builder = new(strings.Builder)
+	builder.WriteString("/" + schema.Name)
+	for _, arg := range schema.Args {
+		var name = arg.Name
+		if arg.Rest {
+			name += "..."
+		}
+		if arg.Required {
+			builder.WriteString(" <" + name + ">")
+		} else {
+			builder.WriteString(" [" + name + "]")
+		}
+	}
+	for _, flag := range schema.Flags {
+		if flag.Type == Bool {
+			builder.WriteString(" [--" + flag.Name + "]")
+		} else {
+			builder.WriteString(" [--" + flag.Name + " " + flag.Type.String() + "]")
+		}
+	}
+	return builder.String()
+}
+
+//UsageError arguments do not match the schema, Error is ready to be sent to the user
+type UsageError struct {
+	Schema  Schema
+	Message string
+}
+
+func (e *UsageError) Error() string {
+	return e.Message + "\nUsage: " + e.Schema.Usage()
+}
@@ -0,0 +1,88 @@
+package command
+
+import (
+	"errors"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+//token word of arguments, quoted words are never treated as flags. start and end are byte offsets of the word as typed
+type token struct {
+	text       string
+	quoted     bool
+	start, end int
+}
+
+//tokenize splits arguments by spaces. "Double" or 'single' quotes at the start of a word keep spaces,
+//so apostrophes inside words stay as they are. \ escapes spaces, quotes and itself, other backslashes are kept (C:\Games)
+func tokenize(text string) ([]token, error) {
+	var tokens []token
+	var current = new(strings.Builder)
+	var inToken, quoted, escaped bool
+	var quote rune
+	var start int
+	var begin = func(index int) {
+		if !inToken {
+			inToken = true
+			start = index
+		}
+	}
+	for index, char := range text {
+		switch {
+		case escaped:
+			current.WriteRune(char)
+			escaped = false
+		case char == '\\' && quote != '\'' && escapes(text[index+1:]):
+			escaped = true
+			begin(index)
+		case quote != 0:
+			if char == quote {
+				quote = 0
+			} else {
+				current.WriteRune(char)
+			}
+		case !inToken && isQuote(char):
+			quote = closingQuote(char)
+			quoted = true
+			begin(index)
+		case unicode.IsSpace(char):
+			if inToken {
+				tokens = append(tokens, token{text: current.String(), quoted: quoted, start: start, end: index})
+				current.Reset()
+				inToken, quoted = false, false
+			}
+		default:
+			current.WriteRune(char)
+			begin(index)
+		}
+	}
+	if quote != 0 {
+		return nil, errors.New("quote is not closed")
+	}
+	if inToken {
+		tokens = append(tokens, token{text: current.String(), quoted: quoted, start: start, end: len(text)})
+	}
+	return tokens, nil
+}
+
+//escapes backslash escapes the next character if it is a space, a quote or another backslash
+func escapes(next string) bool {
+	var char, _ = utf8.DecodeRuneInString(next)
+	return char == '\\' || unicode.IsSpace(char) || isQuote(char) || char == '»' || char == '”'
+}
+
+func isQuote(char rune) bool {
+	return char == '"' || char == '\'' || char == '«' || char == '“'
+}
+
+//closingQuote clients replace straight quotes with typographic ones
+func closingQuote(opening rune) rune {
+	switch opening {
+	case '«':
+		return '»'
+	case '“':
+		return '”'
+	}
+	return opening
+}
@@ -0,0 +1,187 @@
+package commands
+
+import (
+	"bitbucket.org/y4cxp543/aria2c"
+	"bitbucket.org/y4cxp543/telegram-bot/cache"
+	"bitbucket.org/y4cxp543/telegram-bot/constants"
+	"bitbucket.org/y4cxp543/telegram-bot/external/aria_router"
+	"bitbucket.org/y4cxp543/telegram-bot/interfaces"
+	"bitbucket.org/y4cxp543/telegram-bot/telegram"
+	"bitbucket.org/y4cxp543/telegram-bot/telegram/command"
+	"bitbucket.org/y4cxp543/telegram-bot/telegram/models"
+	"context"
+	"encoding/base64"
+	"github.com/asaskevich/EventBus"
+	"github.com/gorilla/websocket"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+const (
+	ariaSecret   = "secret"
+	uriGid       = "2089b05ecca3d829"
+	torrentGid   = "d2ad4b1c1f3d9a40"
+	torrentBytes = "d8:announce0:e"
+)
+
+//fakeAria aria2 JSON-RPC over WebSocket: answers addUri and addTorrent with a gid,
+//then notifies that the download started and completed, the notifications must not disturb the answers
+func fakeAria(t *testing.T, requests chan<- aria2c.Request) *httptest.Server {
+	var upgrader websocket.Upgrader
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var conn, err = upgrader.Upgrade(w, r, nil)
+		if err != nil {
+			t.Error(err)
+			return
+		}
+		defer conn.Close()
+		for {
+			var request aria2c.Request
+			if err := conn.ReadJSON(&request); err != nil {
+				return
+			}
+			requests <- request
+			var gid string
+			switch request.Method {
+			case aria2c.AddUri:
+				gid = uriGid
+			case aria2c.AddTorrent:
+				gid = torrentGid
+			default:
+				t.Errorf("unexpected request %s", request.Method)
+				continue
+			}
+			var messages = []interface{}{
+				map[string]interface{}{"jsonrpc": "2.0", "id": request.Id, "result": gid},
+				map[string]interface{}{"jsonrpc": "2.0", "method": "aria2.onDownloadStart", "params": []interface{}{map[string]string{"gid": gid}}},
+				map[string]interface{}{"jsonrpc": "2.0", "method": "aria2.onBtDownloadComplete", "params": []interface{}{map[string]string{"gid": gid}}},
+			}
+			for _, message := range messages {
+				if err := conn.WriteJSON(message); err != nil {
+					t.Error(err)
+					return
+				}
+			}
+		}
+	}))
+}
+
+//fakeBotApi serves getFile and the file of a sent .torrent document
+func fakeBotApi() *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case strings.HasSuffix(r.URL.Path, "/getFile"):
+			_, _ = w.Write([]byte(`{"ok":true,"result":{"file_id":"f","file_unique_id":"u","file_path":"documents/file.torrent"}}`))
+		case r.URL.Path == "/file/documents/file.torrent":
+			_, _ = w.Write([]byte(torrentBytes))
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+}
+
+type tracked struct {
+	chatId    int64
+	messageId int
+	gid, name string
+}
+
+//fakeProgress remembers downloads the processor asked to track
+type fakeProgress chan tracked
+
+func (progress fakeProgress) Track(ctx context.Context, chatId int64, replyToMessageId int, gid, name string, onComplete func(gid string)) error {
+	progress <- tracked{chatId: chatId, messageId: replyToMessageId, gid: gid, name: name}
+	return nil
+}
+
+type noDialogs struct{}
+
+func (noDialogs) Ask(chatId, userId int64, step string, data map[string]string)    {}
+func (noDialogs) HandleStep(step string, handler interfaces.IConversationStepFunc) {}
+func (noDialogs) Cancel(chatId, userId int64) bool                                 { return false }
+
+func TestDownloadsAreAddedToAria(t *testing.T) {
+	var requests = make(chan aria2c.Request, 10)
+	var aria = fakeAria(t, requests)
+	defer aria.Close()
+	var botApi = fakeBotApi()
+	defer botApi.Close()
+
+	var conn, _, err = websocket.DefaultDialer.Dial("ws"+strings.TrimPrefix(aria.URL, "http")+constants.AriaRPCPath, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var bus = EventBus.New()
+	var ariaCache = new(cache.TemporaryCache)
+	var ariaApi = aria_router.NewLocalAriaWS(ariaCache, ariaSecret)
+	ariaApi.SetConnection(conn)
+	defer ariaApi.Disconnect()
+	ariaApi.Router(aria_router.NewWSRouter(ariaCache,
+		aria_router.NewResponseHandler(ariaCache, bus), aria_router.NewNotificationHandler(ariaCache, bus)))
+
+	var progress = make(fakeProgress, 10)
+	var tFunctions = telegram.NewTFunctions(botApi.URL+"/bot/${method}", botApi.URL+"/file/${filePath}", botApi.Client(), time.Second, false)
+	var processor = NewCommandProcessor(new(cache.TemporaryCache), bus, tFunctions, ariaApi, nil, noDialogs{}, progress, t.TempDir())
+	var parser = command.NewParser()
+	for _, registered := range Commands(processor) {
+		parser.Register(registered.Schema)
+	}
+
+	var magnet = "magnet:?xt=urn:btih:c9e15763f722f23e98a29decdfae341b98d53056&dn=Ubuntu"
+	var text = "/" + string(constants.ByMagnetLink) + " " + magnet
+	parsed, err := parser.Parse(text, []models.MessageEntity{{Type: "bot_command", Offset: 0, Length: len(constants.ByMagnetLink) + 1}})
+	if err != nil || parsed == nil {
+		t.Fatalf("command is not parsed: %v", err)
+	}
+	processor.ProcessMagnetLink(interfaces.BotCommandArgument{Context: context.Background(), Command: parsed.Name, Args: parsed.Args, ChatId: -1001, MessageId: 7})
+	var request = receiveRequest(t, requests)
+	if request.Method != aria2c.AddUri || len(request.Params) != 2 || request.Params[0] != "token:"+ariaSecret {
+		t.Fatalf("unexpected addUri request %+v", request)
+	}
+	if uris, _ := request.Params[1].([]interface{}); len(uris) != 1 || uris[0] != magnet {
+		t.Fatalf("addUri sends %v, expected %s", request.Params[1], magnet)
+	}
+	var download = receiveTracked(t, progress)
+	if download != (tracked{chatId: -1001, messageId: 7, gid: uriGid, name: "Ubuntu"}) {
+		t.Fatalf("unexpected tracked download %+v", download)
+	}
+
+	var fileSize = len(torrentBytes)
+	processor.ProcessDocument(interfaces.BotCommandArgument{Context: context.Background(), ChatId: 42, MessageId: 8,
+		Response: &models.Update{Message: &models.Message{Document: &models.Document{FileId: "f", FileName: "file.torrent", FileSize: &fileSize}}}})
+	request = receiveRequest(t, requests)
+	if request.Method != aria2c.AddTorrent || len(request.Params) != 2 || request.Params[1] != base64.StdEncoding.EncodeToString([]byte(torrentBytes)) {
+		t.Fatalf("unexpected addTorrent request %+v", request)
+	}
+	download = receiveTracked(t, progress)
+	if download != (tracked{chatId: 42, messageId: 8, gid: torrentGid}) {
+		t.Fatalf("unexpected tracked download %+v", download)
+	}
+}
+
+const timeout = 5 * time.Second
+
+func receiveRequest(t *testing.T, requests <-chan aria2c.Request) aria2c.Request {
+	t.Helper()
+	select {
+	case request := <-requests:
+		return request
+	case <-time.After(timeout):
+		t.Fatal("aria2 received no request")
+	}
+	return aria2c.Request{}
+}
+
+func receiveTracked(t *testing.T, progress fakeProgress) tracked {
+	t.Helper()
+	select {
+	case download := <-progress:
+		return download
+	case <-time.After(timeout):
+		t.Fatal("download is not tracked")
+	}
+	return tracked{}
+}
@@ -5,129 +5,248 @@ import (
 	"bitbucket.org/y4cxp543/telegram-bot/constants"
 	"bitbucket.org/y4cxp543/telegram-bot/external/torrentz2"
 	"bitbucket.org/y4cxp543/telegram-bot/interfaces"
-	"bitbucket.org/y4cxp543/telegram-bot/telegram"
+	"bitbucket.org/y4cxp543/telegram-bot/telegram/callback"
+	"bitbucket.org/y4cxp543/telegram-bot/telegram/conversation"
+	"bitbucket.org/y4cxp543/telegram-bot/telegram/format"
+	"bitbucket.org/y4cxp543/telegram-bot/telegram/middleware"
 	"bitbucket.org/y4cxp543/telegram-bot/telegram/models"
+	"bitbucket.org/y4cxp543/telegram-bot/util"
 	"encoding/base64"
 	"github.com/asaskevich/EventBus"
 	"log"
+	"net/url"
 	"regexp"
+	"strconv"
+	"strings"
+	"sync"
 )
 
+const magnetPrefix = "magnet:?"
+
 type commandProcessor struct {
 	Cache      interfaces.Cache
 	EventBus   EventBus.Bus
 	TFunctions interfaces.ITelegramFunctions
-	/*AriaApi    aria2c.AriaWSSender*/
+	AriaApi    interfaces.IAriaApi
+	Pager      interfaces.IPager
+	Dialogs    interfaces.IConversations
+	Progress   interfaces.IProgress
+	//DownloadDir directory of aria2 downloads, finished files are looked for there
+	DownloadDir string
+	//enqueueMutex makes sending request to aria2 and caching its id atomic for AriaReceived
+	enqueueMutex sync.Mutex
+}
+
+func NewCommandProcessor(Cache interfaces.Cache, EventBus EventBus.Bus, TFunctions interfaces.ITelegramFunctions, AriaApi interfaces.IAriaApi, Pager interfaces.IPager, Dialogs interfaces.IConversations, Progress interfaces.IProgress, DownloadDir string) *commandProcessor {
+	var processor = &commandProcessor{Cache: Cache,
+		EventBus:    EventBus,
+		TFunctions:  TFunctions,
+		AriaApi:     AriaApi,
+		Pager:       Pager,
+		Dialogs:     Dialogs,
+		Progress:    Progress,
+		DownloadDir: DownloadDir,
+	}
+	Dialogs.HandleStep(searchQueryStep, processor.searchQueryAnswered)
+	Dialogs.HandleStep(torrentFileStep, processor.torrentFileAnswered)
+	//Handlers subscribe and unsubscribe inside, so they must not run under the publishing lock
+	_ = EventBus.SubscribeAsync(aria2c.AddTorrent, processor.AriaReceived, false)
+	_ = EventBus.SubscribeAsync(aria2c.AddUri, processor.AriaReceived, false)
+	_ = EventBus.SubscribeAsync(aria2c.GetFiles, processor.FilesReceived, false)
+	return processor
+}
+
+//enqueue sends request to aria2 and remembers who asked for it
+func (command *commandProcessor) enqueue(send func() string, botCommandArg interfaces.BotCommandArgument) {
+	command.enqueueMutex.Lock()
+	defer command.enqueueMutex.Unlock()
+	var requestId = send()
+	command.Cache.Put(requestId, botCommandArg)
 }
 
-func NewCommandProcessor(Cache interfaces.Cache, EventBus EventBus.Bus, TFunctions interfaces.ITelegramFunctions /*, AriaApi aria2c.AriaWSSender*/) *commandProcessor {
-	return &commandProcessor{Cache: Cache,
-		EventBus:   EventBus,
-		TFunctions: TFunctions,
-		/*AriaApi:    AriaApi,*/
+//reply sends formatted text as a reply, long text is split into several messages or sent as a file
+func (command *commandProcessor) reply(botCommandArg interfaces.BotCommandArgument, text *format.Builder) {
+	if err := format.Reply(botCommandArg.Context, command.TFunctions, botCommandArg.ChatId, botCommandArg.MessageId, text); err != nil {
+		log.Println(err)
 	}
 }
 
 func (command *commandProcessor) ProcessDocument(botCommandArg interfaces.BotCommandArgument) {
-	if constants.ByFile.Equals(botCommandArg.Command) {
-		if botCommandArg.Response.Message != nil && botCommandArg.Response.Message.Document != nil {
-			var document = botCommandArg.Response.Message.Document
-			if !regexp.MustCompile(".*\\.torrent$").MatchString(document.FileName) {
-				_, _ = command.TFunctions.SendMessage(models.SendMessage{
-					ChatId:           botCommandArg.ChatId,
-					Text:             "Wrong file format. Pattern '.*\\.torrent&'",
-					ReplyToMessageId: botCommandArg.MessageId,
-				})
-				return
-			}
-			var file, _ = command.TFunctions.GetFile(document.FileId)
-			var fileBytes = command.TFunctions.DownloadFile(file.FilePath)
-			var b64 = base64.StdEncoding.EncodeToString(fileBytes)
-			log.Println(b64)
-			/*var uuid = command.AriaApi.AddTorrent(b64)*/
-			/*command.Cache.Put(uuid, botCommandArg)
-			command.EventBus.Subscribe(uuid, command.AriaReceived)*/
-		}
+	if botCommandArg.Response.Message == nil || botCommandArg.Response.Message.Document == nil {
+		command.reply(botCommandArg, format.Markdown().Text("Send .torrent file as a document. Send ").Code("/"+conversation.Cancel).Text(" to stop"))
+		command.Dialogs.Ask(botCommandArg.ChatId, botCommandArg.UserId, torrentFileStep, nil)
+		return
 	}
+	command.downloadTorrentFile(botCommandArg, botCommandArg.Response.Message.Document)
 }
 
-func (command *commandProcessor) AriaReceived(req *aria2c.Request, resp *aria2c.Response) {
+func (command *commandProcessor) downloadTorrentFile(botCommandArg interfaces.BotCommandArgument, document *models.Document) {
+	if !regexp.MustCompile(".*\\.torrent$").MatchString(document.FileName) {
+		command.reply(botCommandArg, format.Markdown().Text("Wrong file format, expected ").Code(".torrent").Text(" file"))
+		return
+	}
+	if limit := command.TFunctions.FileLimits().Download; limit > 0 && document.FileSize != nil && int64(*document.FileSize) > limit {
+		command.reply(botCommandArg, format.Markdown().Text("File is too big to download from Telegram: ").Bold(document.FileName))
+		return
+	}
+	var file, err = command.TFunctions.GetFile(botCommandArg.Context, models.GetFile{FileId: document.FileId})
+	if err != nil {
+		log.Println(err)
+		command.reply(botCommandArg, format.Markdown().Text("Cannot get file from Telegram: ").Bold(document.FileName))
+		return
+	}
+	fileBytes, err := command.TFunctions.DownloadFile(botCommandArg.Context, file.FilePath)
+	if err != nil {
+		log.Println(err)
+		command.reply(botCommandArg, format.Markdown().Text("Cannot download file from Telegram: ").Bold(document.FileName))
+		return
+	}
+	var b64 = base64.StdEncoding.EncodeToString(fileBytes)
+	command.enqueue(func() string {
+		return command.AriaApi.AddTorrent(b64)
+	}, botCommandArg)
+}
 
+//AriaReceived handles aria2 answer for addTorrent and addUri requests
+func (command *commandProcessor) AriaReceived(req *aria2c.Request, resp *aria2c.Response) {
+	command.enqueueMutex.Lock()
 	var tmp = command.Cache.Get(req.Id)
-	if tmp != nil {
-		var gid = resp.Result.(string)
-		var botArguments = tmp.(interfaces.BotCommandArgument)
-		command.TFunctions.SendMessage(models.SendMessage{
-			ChatId:                botArguments.ChatId,
-			Text:                  "Aria Received. Gid: " + gid,
-			DisableWebPagePreview: false,
-			DisableNotification:   false,
-			ReplyToMessageId:      botArguments.MessageId,
-			ReplyMarkup:           models.InlineKeyboardMarkup{},
-		})
-		command.Cache.Put(gid, botArguments)
-		command.EventBus.Subscribe(gid, command.DownloadStarted)
-
-	}
-	command.EventBus.Unsubscribe(req.Id, command.AriaReceived)
+	command.enqueueMutex.Unlock()
+	if tmp == nil {
+		return
+	}
+	var botArguments = tmp.(interfaces.BotCommandArgument)
+	if resp.Error != nil {
+		command.reply(botArguments, format.Markdown().Line("Aria2 cannot add download:").Pre(util.GetAriaError(resp), "json"))
+		return
+	}
+	var gid, ok = resp.Result.(string)
+	if !ok {
+		command.reply(botArguments, format.Markdown().Text("Aria2 returned unexpected answer"))
+		return
+	}
+	var name = magnetName(strings.TrimSpace(botArguments.Argument))
+	var onComplete = func(gid string) {
+		command.enqueue(func() string {
+			return command.AriaApi.GetFilesOf(gid)
+		}, botArguments)
+	}
+	if err := command.Progress.Track(botArguments.Context, botArguments.ChatId, botArguments.MessageId, gid, name, onComplete); err != nil {
+		log.Println(err)
+		command.reply(botArguments, format.Markdown().Text("Aria Received. Gid: ").Code(gid))
+	}
+}
+
+func (command *commandProcessor) ProcessSearchTorrents(botCommandArg interfaces.BotCommandArgument) {
+	var query = botCommandArg.Args.String("query")
+	var limit = botCommandArg.Args.Int("limit")
+	if query == constants.EmptyString {
+		command.reply(botCommandArg, format.Markdown().Text("What to search? Send ").Code("/"+conversation.Cancel).Text(" to stop"))
+		command.Dialogs.Ask(botCommandArg.ChatId, botCommandArg.UserId, searchQueryStep, map[string]string{limitKey: strconv.Itoa(limit)})
+		return
+	}
+	command.search(botCommandArg, query, limit)
 }
 
-func (command *commandProcessor) DownloadStarted(gid string) {
-
-	var tmp = command.Cache.Get(gid)
-	if tmp != nil {
-		var botArguments = tmp.(interfaces.BotCommandArgument)
-		command.TFunctions.SendMessage(models.SendMessage{
-			ChatId:                botArguments.ChatId,
-			Text:                  "Download started. Gid: " + gid,
-			DisableWebPagePreview: false,
-			DisableNotification:   false,
-			ReplyToMessageId:      botArguments.MessageId,
-			ReplyMarkup:           models.InlineKeyboardMarkup{},
-		})
-		command.Cache.Put(gid, botArguments)
-	}
-	command.EventBus.Unsubscribe(gid, command.DownloadStarted)
-	command.EventBus.Subscribe(gid, command.BtDownloadCompleted)
+func (command *commandProcessor) search(botCommandArg interfaces.BotCommandArgument, query string, limit int) {
+	var results, err = torrentz2.Search(query)
+	if err != nil {
+		command.reply(botCommandArg, format.Markdown().Text("Search failed: ").Italic(err.Error()))
+		return
+	}
+	if len(results) == 0 {
+		command.reply(botCommandArg, format.Markdown().Text("Nothing found for ").Bold(query))
+		return
+	}
+	if limit > 0 && limit < len(results) {
+		results = results[:limit]
+	}
+	var items = make([]string, len(results))
+	for index, result := range results {
+		items[index] = util.AddSpacesBetweenStrings(result.Name, result.Size, result.Age)
+	}
+	var onPick = func(arg interfaces.CallbackArgument, index int) (string, error) {
+		return command.ResultPicked(botCommandArg, results[index])
+	}
+	if err := command.Pager.Show(botCommandArg.Context, botCommandArg.ChatId, botCommandArg.UserId, botCommandArg.MessageId, "Results for: "+query, items, onPick); err != nil {
+		log.Println(err)
+	}
 }
 
-func (command *commandProcessor) BtDownloadCompleted(gid string) {
-
-	var tmp = command.Cache.Get(gid)
-	if tmp != nil {
-		var botArguments = tmp.(interfaces.BotCommandArgument)
-		command.TFunctions.SendMessage(models.SendMessage{
-			ChatId:                botArguments.ChatId,
-			Text:                  "Download Completed. Gid: " + gid,
-			DisableWebPagePreview: false,
-			DisableNotification:   false,
-			ReplyToMessageId:      botArguments.MessageId,
-			ReplyMarkup:           models.InlineKeyboardMarkup{},
-		})
-	}
-	command.EventBus.Unsubscribe(gid, command.BtDownloadCompleted)
+//ResultPicked resolves magnet link of search result chosen in the result browser and adds it to aria2.
+//Loading the detail page takes a while, so the button is answered at once
+func (command *commandProcessor) ResultPicked(botCommandArg interfaces.BotCommandArgument, result torrentz2.Result) (string, error) {
+	go command.resolve(botCommandArg, result)
+	if result.Name == constants.EmptyString {
+		return "Starting download", nil
+	}
+	return "Starting download: " + result.Name, nil
 }
 
-func (command *commandProcessor) ProcessSearchTorrents(botCommandArg interfaces.BotCommandArgument) {
-	if constants.Search.Equals(botCommandArg.Command) {
-		var results, error = torrentz2.Search(botCommandArg.Argument)
-		if error == nil {
-			var poll = telegram.CreatePollFromResults(botCommandArg.ChatId, botCommandArg.MessageId, 1, results)
-			var response, err = command.TFunctions.SendPoll(poll)
-			if err != nil {
-				log.Println(err)
-			}
-			command.Cache.Put(response.Poll.Id, results)
+//resolve runs after the callback is answered and its middleware returned, so it recovers panics itself
+func (command *commandProcessor) resolve(botCommandArg interfaces.BotCommandArgument, result torrentz2.Result) {
+	var request = &interfaces.HandlerRequest{
+		Context: botCommandArg.Context,
+		Kind:    interfaces.CallbackHandler,
+		Name:    "resolve",
+		ChatId:  botCommandArg.ChatId,
+		UserId:  botCommandArg.UserId,
+	}
+	var err = middleware.Recovery()(func(*interfaces.HandlerRequest) error {
+		var magnetLink, err = torrentz2.Resolve(result)
+		if err != nil {
+			log.Println(err)
+			command.reply(botCommandArg, format.Markdown().Text("Cannot get magnet link of ").Bold(result.Name))
+			return nil
 		}
+		if magnetName(magnetLink) == constants.EmptyString && result.Name != constants.EmptyString {
+			magnetLink += "&dn=" + url.QueryEscape(result.Name)
+		}
+		botCommandArg.Argument = magnetLink
+		command.addMagnet(botCommandArg, magnetLink)
+		return nil
+	})(request)
+	if err != nil {
+		command.reply(botCommandArg, format.Markdown().Text("Something went wrong, cannot download ").Bold(result.Name))
 	}
 }
 
+//DownloadResult starts download of the search result from the button of a message posted in inline mode.
+//The message may be in a chat without the bot, so the user who pressed the button is answered in private
+func (command *commandProcessor) DownloadResult(arg interfaces.CallbackArgument, result torrentz2.Result) (string, error) {
+	if arg.Query == nil || arg.Query.From == nil {
+		return constants.EmptyString, callback.ErrExpired
+	}
+	var userId = arg.Query.From.Id
+	return command.ResultPicked(interfaces.BotCommandArgument{Context: arg.Context, ChatId: userId, UserId: userId}, result)
+}
+
 func (command *commandProcessor) ProcessMagnetLink(botCommandArg interfaces.BotCommandArgument) {
-	if constants.ByMagnetLink.Equals(botCommandArg.Command) {
-		var magnetLink = botCommandArg.Argument
-		log.Println(magnetLink)
-		var wrapper = make([]string, 1)
-		wrapper[0] = magnetLink
-		/*command.AriaApi.AddUri(wrapper)*/
+	var magnetLink = botCommandArg.Args.String("magnet")
+	if !strings.HasPrefix(magnetLink, magnetPrefix) {
+		command.reply(botCommandArg, format.Markdown().Text("Wrong magnet link. Usage: ").Code("/"+string(constants.ByMagnetLink)+" magnet:?xt=urn:btih:..."))
+		return
+	}
+	botCommandArg.Argument = magnetLink
+	command.addMagnet(botCommandArg, magnetLink)
+}
+
+func (command *commandProcessor) addMagnet(botCommandArg interfaces.BotCommandArgument, magnetLink string) {
+	var wrapper = make([]string, 1)
+	wrapper[0] = magnetLink
+	command.enqueue(func() string {
+		return command.AriaApi.AddUri(wrapper)
+	}, botCommandArg)
+}
+
+//magnetName display name (dn) of magnet link or empty string
+func magnetName(magnetLink string) string {
+	if !strings.HasPrefix(magnetLink, magnetPrefix) {
+		return constants.EmptyString
+	}
+	var query, err = url.ParseQuery(strings.TrimPrefix(magnetLink, magnetPrefix))
+	if err != nil {
+		return constants.EmptyString
 	}
+	return query.Get("dn")
 }
@@ -0,0 +1,44 @@
+package commands
+
+import (
+	"bitbucket.org/y4cxp543/telegram-bot/constants"
+	"bitbucket.org/y4cxp543/telegram-bot/interfaces"
+	"bitbucket.org/y4cxp543/telegram-bot/telegram/command"
+	"bitbucket.org/y4cxp543/telegram-bot/telegram/registry"
+)
+
+//Commands declares the commands handled by the processor
+func Commands(processor interfaces.ICommandProcessor) []registry.Command {
+	return []registry.Command{
+		{
+			Schema: command.Schema{
+				Name:        string(constants.Search),
+				Description: "Search torrents",
+				Args:        []command.Arg{{Name: "query", Rest: true, Description: "Words to search, asked if missing"}},
+				Flags:       []command.Flag{{Name: "limit", Type: command.Int, Description: "Show only first results"}},
+			},
+			Descriptions: map[string]string{"ru": "Поиск торрентов"},
+			Role:         constants.RoleUser,
+			Handler:      processor.ProcessSearchTorrents,
+		},
+		{
+			Schema: command.Schema{
+				Name:        string(constants.ByMagnetLink),
+				Description: "Download by magnet link",
+				Args:        []command.Arg{{Name: "magnet", Required: true, Description: "magnet:?xt=urn:btih:..."}},
+			},
+			Descriptions: map[string]string{"ru": "Скачать по magnet-ссылке"},
+			Role:         constants.RoleUser,
+			Handler:      processor.ProcessMagnetLink,
+		},
+		{
+			Schema: command.Schema{
+				Name:        string(constants.ByFile),
+				Description: "Download by .torrent file sent as a document with the command in the caption",
+			},
+			Descriptions: map[string]string{"ru": "Скачать по .torrent файлу, команда в подписи к документу"},
+			Role:         constants.RoleUser,
+			Handler:      processor.ProcessDocument,
+		},
+	}
+}
@@ -0,0 +1,53 @@
+package commands
+
+import (
+	"bitbucket.org/y4cxp543/telegram-bot/constants"
+	"bitbucket.org/y4cxp543/telegram-bot/interfaces"
+	"bitbucket.org/y4cxp543/telegram-bot/telegram/format"
+	"bitbucket.org/y4cxp543/telegram-bot/telegram/models"
+	"strconv"
+	"strings"
+)
+
+//Steps of the dialogs started by commands sent without arguments
+const (
+	searchQueryStep = "search.query"
+	torrentFileStep = "byFile.document"
+)
+
+const limitKey = "limit"
+
+//dialogArgument answer in the dialog as if it came with the command
+func dialogArgument(command constants.BotCommands, input interfaces.ConversationInput) interfaces.BotCommandArgument {
+	return interfaces.BotCommandArgument{
+		Context:   input.Context,
+		Command:   string(command),
+		Argument:  input.Text,
+		MessageId: input.MessageId,
+		ChatId:    input.ChatId,
+		UserId:    input.UserId,
+		Response:  &models.Update{Message: input.Message},
+	}
+}
+
+func (command *commandProcessor) searchQueryAnswered(state *interfaces.ConversationState, input interfaces.ConversationInput) (string, error) {
+	var query = strings.TrimSpace(input.Text)
+	var botCommandArg = dialogArgument(constants.Search, input)
+	if query == constants.EmptyString {
+		command.reply(botCommandArg, format.Markdown().Text("Send words to search"))
+		return state.Step, nil
+	}
+	var limit, _ = strconv.Atoi(state.Data[limitKey])
+	command.search(botCommandArg, query, limit)
+	return constants.EmptyString, nil
+}
+
+func (command *commandProcessor) torrentFileAnswered(state *interfaces.ConversationState, input interfaces.ConversationInput) (string, error) {
+	var botCommandArg = dialogArgument(constants.ByFile, input)
+	if input.Message == nil || input.Message.Document == nil {
+		command.reply(botCommandArg, format.Markdown().Text("Waiting for ").Code(".torrent").Text(" file as a document"))
+		return state.Step, nil
+	}
+	command.downloadTorrentFile(botCommandArg, input.Message.Document)
+	return constants.EmptyString, nil
+}
@@ -0,0 +1,144 @@
+package commands
+
+import (
+	"bitbucket.org/y4cxp543/aria2c"
+	"bitbucket.org/y4cxp543/telegram-bot/constants"
+	"bitbucket.org/y4cxp543/telegram-bot/interfaces"
+	"bitbucket.org/y4cxp543/telegram-bot/telegram/format"
+	"bitbucket.org/y4cxp543/telegram-bot/telegram/models"
+	"bitbucket.org/y4cxp543/telegram-bot/util"
+	"log"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+//downloadedFile file of aria2 download as returned by aria2.getFiles
+type downloadedFile struct {
+	Path   string
+	Length int64
+}
+
+//FilesReceived handles aria2 answer for getFiles and sends files of the finished download to the chat
+func (command *commandProcessor) FilesReceived(req *aria2c.Request, resp *aria2c.Response) {
+	command.enqueueMutex.Lock()
+	var tmp = command.Cache.Get(req.Id)
+	command.enqueueMutex.Unlock()
+	if tmp == nil {
+		return
+	}
+	var botArguments = tmp.(interfaces.BotCommandArgument)
+	if resp.Error != nil {
+		command.reply(botArguments, format.Markdown().Line("Aria2 cannot list downloaded files:").Pre(util.GetAriaError(resp), "json"))
+		return
+	}
+	var limits = command.TFunctions.FileLimits()
+	var uploads []models.InputFile
+	//Torrents may have thousands of files, the list is sent as a file then
+	var tooBig = format.Markdown().Bold("Files too big to send:").Line("").FileName("too_big.txt")
+	var tooBigCount = 0
+	for _, file := range downloadedFiles(resp, command.DownloadDir) {
+		if limits.Upload > 0 && file.Length > limits.Upload {
+			tooBig.Text("- ").Code(filepath.Base(file.Path)).Line("")
+			tooBigCount++
+			continue
+		}
+		uploads = append(uploads, inputFile(file.Path, limits.Local))
+	}
+	if tooBigCount > 0 {
+		command.reply(botArguments, tooBig)
+	}
+	command.sendFiles(botArguments, uploads)
+}
+
+//inputFile server in local mode reads the file from disk by file:// URI, otherwise the file is uploaded
+func inputFile(path string, local bool) models.InputFile {
+	if absPath, err := filepath.Abs(path); local && err == nil {
+		absPath = filepath.ToSlash(absPath)
+		//Windows paths start with the drive letter
+		if !strings.HasPrefix(absPath, "/") {
+			absPath = "/" + absPath
+		}
+		return models.NewInputFileId("file://" + absPath)
+	}
+	return models.NewInputFilePath(path)
+}
+
+//sendFiles sends files as albums of documents, album holds 2-10 of them, so single file is sent as a document
+func (command *commandProcessor) sendFiles(botCommandArg interfaces.BotCommandArgument, uploads []models.InputFile) {
+	for start := 0; start < len(uploads); start += constants.TelegramMaxMediaGroupSize {
+		var end = start + constants.TelegramMaxMediaGroupSize
+		if end > len(uploads) {
+			end = len(uploads)
+		}
+		if end-start == 1 {
+			command.sendDocument(botCommandArg, uploads[start])
+			continue
+		}
+		var media = make([]models.InputMedia, 0, end-start)
+		for _, upload := range uploads[start:end] {
+			media = append(media, models.InputMediaDocument{Media: upload})
+		}
+		if _, err := command.TFunctions.SendMediaGroup(botCommandArg.Context, models.SendMediaGroup{
+			ChatId:           models.NewChatID(botCommandArg.ChatId),
+			Media:            media,
+			ReplyToMessageId: models.Int(botCommandArg.MessageId),
+		}); err != nil {
+			log.Println(err)
+			command.reply(botCommandArg, format.Markdown().Text("Cannot send files "+strconv.Itoa(start+1)+"-"+strconv.Itoa(end)))
+		}
+	}
+}
+
+func (command *commandProcessor) sendDocument(botCommandArg interfaces.BotCommandArgument, upload models.InputFile) {
+	if _, err := command.TFunctions.SendDocument(botCommandArg.Context, models.SendDocument{
+		ChatId:           models.NewChatID(botCommandArg.ChatId),
+		Document:         upload,
+		ReplyToMessageId: models.Int(botCommandArg.MessageId),
+	}); err != nil {
+		log.Println(err)
+		var name = upload.FileName()
+		if name == constants.EmptyString {
+			//Files of the local server are sent by file:// URI
+			name = filepath.Base(upload.FileId)
+		}
+		command.reply(botCommandArg, format.Markdown().Text("Cannot send file ").Code(name))
+	}
+}
+
+//downloadedFiles selected files of aria2.getFiles result which lie inside downloadDir.
+//Files outside of it are never sent, whatever path aria2 reports
+func downloadedFiles(resp *aria2c.Response, downloadDir string) []downloadedFile {
+	var items, ok = resp.Result.([]interface{})
+	if !ok {
+		return nil
+	}
+	var files []downloadedFile
+	for _, item := range items {
+		var fields, isMap = item.(map[string]interface{})
+		if !isMap || fields["selected"] == "false" {
+			continue
+		}
+		var path, _ = fields["path"].(string)
+		if path == constants.EmptyString || !insideDir(path, downloadDir) {
+			continue
+		}
+		var lengthText, _ = fields["length"].(string)
+		var length, _ = strconv.ParseInt(lengthText, 10, 64)
+		files = append(files, downloadedFile{Path: path, Length: length})
+	}
+	return files
+}
+
+func insideDir(path, dir string) bool {
+	var absPath, err = filepath.Abs(path)
+	if err != nil {
+		return false
+	}
+	absDir, err := filepath.Abs(dir)
+	if err != nil {
+		return false
+	}
+	relative, err := filepath.Rel(absDir, absPath)
+	return err == nil && relative != ".." && !strings.HasPrefix(relative, ".."+string(filepath.Separator))
+}
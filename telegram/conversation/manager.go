@@ -0,0 +1,219 @@
+package conversation
+
+import (
+	"bitbucket.org/y4cxp543/telegram-bot/constants"
+	"bitbucket.org/y4cxp543/telegram-bot/interfaces"
+	"bitbucket.org/y4cxp543/telegram-bot/telegram/callback"
+	"bitbucket.org/y4cxp543/telegram-bot/telegram/command"
+	"bitbucket.org/y4cxp543/telegram-bot/telegram/middleware"
+	"bitbucket.org/y4cxp543/telegram-bot/telegram/models"
+	"bitbucket.org/y4cxp543/telegram-bot/telegram/registry"
+	"errors"
+	"log"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+//Prefix callback_data prefix of buttons answering the dialog: "cv:<answer>"
+const Prefix = "cv"
+
+//Cancel command which stops the dialog
+const Cancel = "cancel"
+
+const DefaultTimeout = 5 * time.Minute
+
+const keyPrefix = "conversation "
+
+//Manager keeps dialogs in the cache by chat and user. A dialog is taken from the cache while its step is handled,
+//so the answers of one user are never handled concurrently
+type Manager struct {
+	mutex      sync.RWMutex
+	steps      map[string]interfaces.IConversationStepFunc
+	cache      interfaces.Cache
+	store      interfaces.ConversationStore
+	tFunctions interfaces.ITelegramFunctions
+	clock      interfaces.Clock
+	timeout    time.Duration
+	chain      *middleware.Chain
+}
+
+func NewManager(cache interfaces.Cache, store interfaces.ConversationStore, tFunctions interfaces.ITelegramFunctions, clock interfaces.Clock, timeout time.Duration, chain *middleware.Chain) *Manager {
+	if timeout <= 0 {
+		timeout = DefaultTimeout
+	}
+	return &Manager{
+		steps:      make(map[string]interfaces.IConversationStepFunc),
+		cache:      cache,
+		store:      store,
+		tFunctions: tFunctions,
+		clock:      clock,
+		timeout:    timeout,
+		chain:      chain,
+	}
+}
+
+func key(chatId, userId int64) string {
+	return keyPrefix + strconv.FormatInt(chatId, 10) + constants.Space + strconv.FormatInt(userId, 10)
+}
+
+func (manager *Manager) HandleStep(step string, handler interfaces.IConversationStepFunc) {
+	manager.mutex.Lock()
+	defer manager.mutex.Unlock()
+	manager.steps[step] = handler
+}
+
+func (manager *Manager) Ask(chatId, userId int64, step string, data map[string]string) {
+	manager.put(&interfaces.ConversationState{ChatId: chatId, UserId: userId, Step: step, Data: data})
+}
+
+//Cancel stops the dialog. Returns false if there was none
+func (manager *Manager) Cancel(chatId, userId int64) bool {
+	var state = manager.take(chatId, userId)
+	if state == nil {
+		return false
+	}
+	manager.forget(state)
+	return !manager.expired(state)
+}
+
+//Restore puts dialogs saved before the restart back to the cache
+func (manager *Manager) Restore() {
+	var states, err = manager.store.LoadAll()
+	if err != nil {
+		log.Println("Cannot load conversations: ", err)
+		return
+	}
+	for index := range states {
+		if manager.expired(&states[index]) {
+			_ = manager.store.Delete(states[index].ChatId, states[index].UserId)
+			continue
+		}
+		manager.cache.Put(key(states[index].ChatId, states[index].UserId), &states[index])
+	}
+}
+
+//Handle passes the answer to the step of the dialog. Returns false if the user has no dialog in the chat
+func (manager *Manager) Handle(input interfaces.ConversationInput) bool {
+	var state = manager.take(input.ChatId, input.UserId)
+	if state == nil {
+		return false
+	}
+	if manager.expired(state) {
+		manager.forget(state)
+		manager.reply(input, "The dialog has timed out, start the command again")
+		return true
+	}
+	manager.mutex.RLock()
+	var handler, ok = manager.steps[state.Step]
+	manager.mutex.RUnlock()
+	if !ok {
+		log.Println("Unknown conversation step: ", state.Step)
+		manager.forget(state)
+		return true
+	}
+	//Empty data is not saved, so handlers may always write to it
+	if state.Data == nil {
+		state.Data = make(map[string]string)
+	}
+	var request = &interfaces.HandlerRequest{
+		Context:   input.Context,
+		Kind:      interfaces.MessageHandler,
+		Name:      state.Step,
+		ChatId:    input.ChatId,
+		UserId:    input.UserId,
+		MessageId: input.MessageId,
+	}
+	var next string
+	var err = manager.chain.Run(request, func(*interfaces.HandlerRequest) error {
+		var stepErr error
+		next, stepErr = handler(state, input)
+		return stepErr
+	})
+	switch {
+	case errors.Is(err, middleware.ErrForbidden):
+		manager.forget(state)
+		manager.reply(input, "You are not allowed to continue the dialog")
+	case err != nil:
+		log.Println("Conversation step "+state.Step+" failed: ", err)
+		manager.forget(state)
+		manager.reply(input, "Something went wrong, the dialog is cancelled")
+	case next == constants.EmptyString:
+		manager.forget(state)
+	default:
+		state.Step = next
+		manager.put(state)
+	}
+	return true
+}
+
+//HandleCallback passes pressed button built with callback.Encode(Prefix, answer) to the dialog of the user
+func (manager *Manager) HandleCallback(arg interfaces.CallbackArgument) (string, error) {
+	if arg.Query == nil || arg.Query.From == nil {
+		return "", callback.ErrExpired
+	}
+	var input = interfaces.ConversationInput{
+		Context:   arg.Context,
+		ChatId:    arg.ChatId,
+		UserId:    arg.Query.From.Id,
+		MessageId: arg.MessageId,
+		Text:      strings.Join(arg.Args, callback.Separator),
+		Callback:  arg.Query,
+	}
+	if !manager.Handle(input) {
+		return "", callback.ErrExpired
+	}
+	return "", nil
+}
+
+//CancelCommand /cancel for the command registry
+func (manager *Manager) CancelCommand() registry.Command {
+	return registry.Command{
+		Schema:       command.Schema{Name: Cancel, Description: "Cancel the dialog"},
+		Descriptions: map[string]string{"ru": "Отменить диалог"},
+		Handler: func(arg interfaces.BotCommandArgument) {
+			var text = "Nothing to cancel"
+			if manager.Cancel(arg.ChatId, arg.UserId) {
+				text = "Cancelled"
+			}
+			manager.reply(interfaces.ConversationInput{Context: arg.Context, ChatId: arg.ChatId, MessageId: arg.MessageId}, text)
+		},
+	}
+}
+
+func (manager *Manager) take(chatId, userId int64) *interfaces.ConversationState {
+	var value = manager.cache.Get(key(chatId, userId))
+	if value == nil {
+		return nil
+	}
+	return value.(*interfaces.ConversationState)
+}
+
+func (manager *Manager) put(state *interfaces.ConversationState) {
+	state.Expires = manager.clock.Now().Add(manager.timeout)
+	manager.cache.Put(key(state.ChatId, state.UserId), state)
+	if err := manager.store.Save(*state); err != nil {
+		log.Println("Cannot save conversation: ", err)
+	}
+}
+
+func (manager *Manager) forget(state *interfaces.ConversationState) {
+	if err := manager.store.Delete(state.ChatId, state.UserId); err != nil {
+		log.Println("Cannot delete conversation: ", err)
+	}
+}
+
+func (manager *Manager) expired(state *interfaces.ConversationState) bool {
+	return manager.clock.Now().After(state.Expires)
+}
+
+func (manager *Manager) reply(input interfaces.ConversationInput, text string) {
+	var request = models.SendMessage{ChatId: models.NewChatID(input.ChatId), Text: text}
+	if input.MessageId != 0 && input.Callback == nil {
+		request.ReplyToMessageId = models.Int(input.MessageId)
+	}
+	if _, err := manager.tFunctions.SendMessage(input.Context, request); err != nil {
+		log.Println(err)
+	}
+}
@@ -0,0 +1,314 @@
+package dispatcher
+
+import (
+	configuration "bitbucket.org/y4cxp543/telegram-bot/models"
+	"bitbucket.org/y4cxp543/telegram-bot/telegram/models"
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"runtime/debug"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+)
+
+const (
+	DefaultWorkers   = 8
+	DefaultQueueSize = 256
+)
+
+//Keys updates are ordered by
+const (
+	OrderByChat = "chat"
+	OrderByUser = "user"
+)
+
+//ErrStopped updates are not accepted after Drain is called
+var ErrStopped = errors.New("dispatcher is stopped")
+
+//Handler handles one update, updates with the same key are never handled concurrently
+type Handler func(update models.Update)
+
+//Committer receives update_id every update up to which is handled, so it can be confirmed
+type Committer func(lastUpdateId int)
+
+//Stats queue metrics
+type Stats struct {
+	Workers int
+	//Queued updates waiting for workers, Active updates being handled
+	Queued, Active int
+	//Keys chats or users with queued or active updates
+	Keys int
+	//Submitted and Handled since start, Panics of handlers
+	Submitted, Handled, Panics int64
+	//Blocked times Submit waited for a free place in the full queue
+	Blocked int64
+	//MaxQueued the largest queue length seen
+	MaxQueued int
+	//Wait average time updates spent in the queue
+	Wait time.Duration
+}
+
+func (stats Stats) String() string {
+	return fmt.Sprintf("workers=%d queued=%d active=%d keys=%d submitted=%d handled=%d panics=%d blocked=%d maxQueued=%d wait=%s",
+		stats.Workers, stats.Queued, stats.Active, stats.Keys, stats.Submitted, stats.Handled, stats.Panics,
+		stats.Blocked, stats.MaxQueued, stats.Wait)
+}
+
+type queued struct {
+	update   models.Update
+	received time.Time
+}
+
+//Dispatcher hands updates to a bounded pool of workers. Updates of one chat (or user) are handled
+//in the order they were received, different chats are handled in parallel
+type Dispatcher struct {
+	mutex     sync.Mutex
+	notFull   *sync.Cond
+	notEmpty  *sync.Cond
+	queues    map[string][]queued
+	ready     []string
+	busy      map[string]bool
+	queued    int
+	capacity  int
+	orderBy   string
+	stopped   bool
+	inFlight  map[int]bool
+	lastId    int
+	committed int
+	stats     Stats
+	waitSum   time.Duration
+	workers   sync.WaitGroup
+	handler   Handler
+	committer Committer
+}
+
+//NewDispatcher starts the workers. Zero settings use DefaultWorkers and DefaultQueueSize
+func NewDispatcher(config configuration.Dispatcher, handler Handler, committer Committer) *Dispatcher {
+	var workers, capacity = config.Workers, config.QueueSize
+	if workers <= 0 {
+		workers = DefaultWorkers
+	}
+	if capacity <= 0 {
+		capacity = DefaultQueueSize
+	}
+	var orderBy = config.OrderBy
+	if orderBy != OrderByUser {
+		orderBy = OrderByChat
+	}
+	var dispatcher = &Dispatcher{
+		queues:    make(map[string][]queued),
+		busy:      make(map[string]bool),
+		inFlight:  make(map[int]bool),
+		capacity:  capacity,
+		orderBy:   orderBy,
+		handler:   handler,
+		committer: committer,
+	}
+	dispatcher.notFull = sync.NewCond(&dispatcher.mutex)
+	dispatcher.notEmpty = sync.NewCond(&dispatcher.mutex)
+	dispatcher.stats.Workers = workers
+	dispatcher.workers.Add(workers)
+	for i := 0; i < workers; i++ {
+		go dispatcher.work()
+	}
+	return dispatcher
+}
+
+//Submit queues the update. Blocks while the queue is full, so updates are not received faster than handled
+func (dispatcher *Dispatcher) Submit(update models.Update) error {
+	var key = dispatcher.keyOf(update)
+	dispatcher.mutex.Lock()
+	defer dispatcher.mutex.Unlock()
+	if dispatcher.queued >= dispatcher.capacity && !dispatcher.stopped {
+		dispatcher.stats.Blocked++
+		for dispatcher.queued >= dispatcher.capacity && !dispatcher.stopped {
+			dispatcher.notFull.Wait()
+		}
+	}
+	if dispatcher.stopped {
+		return ErrStopped
+	}
+	var queue = append(dispatcher.queues[key], queued{update: update, received: time.Now()})
+	dispatcher.queues[key] = queue
+	//A busy key is scheduled again by its worker, a key with older updates is already ready
+	if !dispatcher.busy[key] && len(queue) == 1 {
+		dispatcher.ready = append(dispatcher.ready, key)
+		dispatcher.notEmpty.Signal()
+	}
+	dispatcher.queued++
+	dispatcher.inFlight[update.UpdateId] = true
+	if update.UpdateId > dispatcher.lastId {
+		dispatcher.lastId = update.UpdateId
+	}
+	dispatcher.stats.Submitted++
+	if dispatcher.queued > dispatcher.stats.MaxQueued {
+		dispatcher.stats.MaxQueued = dispatcher.queued
+	}
+	return nil
+}
+
+//keyOf chat or user of the update. Updates without both are not ordered
+func (dispatcher *Dispatcher) keyOf(update models.Update) string {
+	var chatId, userId int64
+	switch message := messageOf(update); {
+	case message != nil:
+		if message.Chat != nil {
+			chatId = message.Chat.Id
+		}
+		if message.From != nil {
+			userId = message.From.Id
+		}
+	case update.CallbackQuery != nil:
+		if update.CallbackQuery.Message != nil && update.CallbackQuery.Message.Chat != nil {
+			chatId = update.CallbackQuery.Message.Chat.Id
+		}
+		if update.CallbackQuery.From != nil {
+			userId = update.CallbackQuery.From.Id
+		}
+	case update.InlineQuery != nil && update.InlineQuery.From != nil:
+		userId = update.InlineQuery.From.Id
+	case update.ChosenInlineResult != nil && update.ChosenInlineResult.From != nil:
+		userId = update.ChosenInlineResult.From.Id
+	}
+	switch {
+	case dispatcher.orderBy == OrderByUser && userId != 0:
+		return "user " + strconv.FormatInt(userId, 10)
+	case chatId != 0:
+		return "chat " + strconv.FormatInt(chatId, 10)
+	case userId != 0:
+		return "user " + strconv.FormatInt(userId, 10)
+	}
+	return "update " + strconv.Itoa(update.UpdateId)
+}
+
+func messageOf(update models.Update) *models.Message {
+	switch {
+	case update.Message != nil:
+		return update.Message
+	case update.EditedMessage != nil:
+		return update.EditedMessage
+	case update.ChannelPost != nil:
+		return update.ChannelPost
+	}
+	return update.EditedChannelPost
+}
+
+func (dispatcher *Dispatcher) work() {
+	defer dispatcher.workers.Done()
+	for {
+		dispatcher.mutex.Lock()
+		for len(dispatcher.ready) == 0 && !dispatcher.stopped {
+			dispatcher.notEmpty.Wait()
+		}
+		if len(dispatcher.ready) == 0 {
+			//Stopped and nothing left, busy keys are finished by their own workers
+			dispatcher.mutex.Unlock()
+			return
+		}
+		var key = dispatcher.ready[0]
+		dispatcher.ready = dispatcher.ready[1:]
+		var next = dispatcher.queues[key][0]
+		dispatcher.queues[key] = dispatcher.queues[key][1:]
+		dispatcher.busy[key] = true
+		dispatcher.queued--
+		dispatcher.stats.Active++
+		dispatcher.waitSum += time.Since(next.received)
+		dispatcher.notFull.Signal()
+		dispatcher.mutex.Unlock()
+
+		var panicked = dispatcher.handle(next.update)
+
+		dispatcher.mutex.Lock()
+		dispatcher.stats.Active--
+		dispatcher.stats.Handled++
+		if panicked {
+			dispatcher.stats.Panics++
+		}
+		delete(dispatcher.busy, key)
+		if len(dispatcher.queues[key]) > 0 {
+			//One update per turn, so a busy chat does not starve the others
+			dispatcher.ready = append(dispatcher.ready, key)
+			dispatcher.notEmpty.Signal()
+		} else {
+			delete(dispatcher.queues, key)
+		}
+		delete(dispatcher.inFlight, next.update.UpdateId)
+		dispatcher.mutex.Unlock()
+		dispatcher.commit()
+	}
+}
+
+//handle returns true if the handler panicked, the worker survives it
+func (dispatcher *Dispatcher) handle(update models.Update) (panicked bool) {
+	defer func() {
+		if recovered := recover(); recovered != nil {
+			log.Printf("Panic handling update %d: %v\n%s", update.UpdateId, recovered, debug.Stack())
+			panicked = true
+		}
+	}()
+	dispatcher.handler(update)
+	return false
+}
+
+//commit confirms the greatest update_id with every earlier update handled
+func (dispatcher *Dispatcher) commit() {
+	if dispatcher.committer == nil {
+		return
+	}
+	dispatcher.mutex.Lock()
+	var done = dispatcher.lastId
+	if len(dispatcher.inFlight) > 0 {
+		var ids = make([]int, 0, len(dispatcher.inFlight))
+		for id := range dispatcher.inFlight {
+			ids = append(ids, id)
+		}
+		sort.Ints(ids)
+		done = ids[0] - 1
+	}
+	if done <= dispatcher.committed {
+		dispatcher.mutex.Unlock()
+		return
+	}
+	dispatcher.committed = done
+	//Committed under the lock, so a slower worker never confirms a smaller id after a greater one
+	dispatcher.committer(done)
+	dispatcher.mutex.Unlock()
+}
+
+//Stats current queue metrics
+func (dispatcher *Dispatcher) Stats() Stats {
+	dispatcher.mutex.Lock()
+	defer dispatcher.mutex.Unlock()
+	var stats = dispatcher.stats
+	stats.Queued = dispatcher.queued
+	stats.Keys = len(dispatcher.queues)
+	if taken := stats.Handled + int64(stats.Active); taken > 0 {
+		stats.Wait = dispatcher.waitSum / time.Duration(taken)
+	}
+	return stats
+}
+
+//Drain stops accepting updates and waits until the queued ones are handled.
+//Returns error if they are not handled before the context is done, the workers keep running then
+func (dispatcher *Dispatcher) Drain(ctx context.Context) error {
+	dispatcher.mutex.Lock()
+	dispatcher.stopped = true
+	dispatcher.notEmpty.Broadcast()
+	dispatcher.notFull.Broadcast()
+	dispatcher.mutex.Unlock()
+	var done = make(chan struct{})
+	go func() {
+		dispatcher.workers.Wait()
+		close(done)
+	}()
+	select {
+	case <-done:
+		return nil
+	case <-ctx.Done():
+		var stats = dispatcher.Stats()
+		return fmt.Errorf("%d queued and %d active updates are not handled: %w", stats.Queued, stats.Active, ctx.Err())
+	}
+}
@@ -0,0 +1,273 @@
+package dispatcher
+
+import (
+	configuration "bitbucket.org/y4cxp543/telegram-bot/models"
+	"bitbucket.org/y4cxp543/telegram-bot/telegram/models"
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+)
+
+const timeout = 5 * time.Second
+
+func update(id int, chatId int64) models.Update {
+	return models.Update{UpdateId: id, Message: &models.Message{MessageId: id, Chat: &models.Chat{Id: chatId}}}
+}
+
+//recorder remembers handled update ids by chat and committed offsets.
+//Handling of the update waits while its gate is open and not closed
+type recorder struct {
+	mutex     sync.Mutex
+	gates     map[int]chan struct{}
+	started   chan int
+	handled   map[int64][]int
+	committed []int
+	panicAt   map[int]bool
+}
+
+func newRecorder() *recorder {
+	return &recorder{
+		gates:   make(map[int]chan struct{}),
+		started: make(chan int, 100),
+		handled: make(map[int64][]int),
+		panicAt: make(map[int]bool),
+	}
+}
+
+//hold makes handling of the update wait until the returned gate is closed
+func (r *recorder) hold(id int) chan struct{} {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	var gate = make(chan struct{})
+	r.gates[id] = gate
+	return gate
+}
+
+func (r *recorder) handle(update models.Update) {
+	r.started <- update.UpdateId
+	r.mutex.Lock()
+	var gate = r.gates[update.UpdateId]
+	var panics = r.panicAt[update.UpdateId]
+	r.mutex.Unlock()
+	if gate != nil {
+		<-gate
+	}
+	r.mutex.Lock()
+	r.handled[update.Message.Chat.Id] = append(r.handled[update.Message.Chat.Id], update.UpdateId)
+	r.mutex.Unlock()
+	if panics {
+		panic("handler failed")
+	}
+}
+
+func (r *recorder) commit(lastUpdateId int) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	r.committed = append(r.committed, lastUpdateId)
+}
+
+func (r *recorder) handledOf(chatId int64) []int {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	return append([]int(nil), r.handled[chatId]...)
+}
+
+func (r *recorder) lastCommitted() int {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	if len(r.committed) == 0 {
+		return 0
+	}
+	return r.committed[len(r.committed)-1]
+}
+
+func (r *recorder) waitStarted(t *testing.T, id int) {
+	t.Helper()
+	for {
+		select {
+		case started := <-r.started:
+			if started == id {
+				return
+			}
+		case <-time.After(timeout):
+			t.Fatalf("update %d is not handled", id)
+		}
+	}
+}
+
+//eventually waits until the condition is true
+func eventually(t *testing.T, what string, condition func() bool) {
+	t.Helper()
+	var deadline = time.Now().Add(timeout)
+	for !condition() {
+		if time.Now().After(deadline) {
+			t.Fatal(what)
+		}
+		time.Sleep(time.Millisecond)
+	}
+}
+
+func drain(t *testing.T, dispatcher *Dispatcher) {
+	t.Helper()
+	var ctx, cancel = context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+	if err := dispatcher.Drain(ctx); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestUpdatesOfChatAreHandledInOrder(t *testing.T) {
+	var r = newRecorder()
+	var dispatcher = NewDispatcher(configuration.Dispatcher{Workers: 4, QueueSize: 8}, r.handle, r.commit)
+	var chats = []int64{1, 2, 3}
+	var expected = make(map[int64][]int)
+	for id := 1; id <= 90; id++ {
+		var chatId = chats[id%len(chats)]
+		expected[chatId] = append(expected[chatId], id)
+		if err := dispatcher.Submit(update(id, chatId)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	drain(t, dispatcher)
+	for _, chatId := range chats {
+		var handled = r.handledOf(chatId)
+		if len(handled) != len(expected[chatId]) {
+			t.Fatalf("chat %d: %d updates handled, expected %d", chatId, len(handled), len(expected[chatId]))
+		}
+		for index := range handled {
+			if handled[index] != expected[chatId][index] {
+				t.Fatalf("chat %d: updates handled in order %v", chatId, handled)
+			}
+		}
+	}
+	if last := r.lastCommitted(); last != 90 {
+		t.Errorf("Committed %d after every update is handled, expected 90", last)
+	}
+}
+
+func TestChatsAreHandledInParallel(t *testing.T) {
+	var r = newRecorder()
+	var dispatcher = NewDispatcher(configuration.Dispatcher{Workers: 2, QueueSize: 8}, r.handle, r.commit)
+	var slow = r.hold(1)
+	if err := dispatcher.Submit(update(1, 1)); err != nil {
+		t.Fatal(err)
+	}
+	r.waitStarted(t, 1)
+	for _, next := range []models.Update{update(2, 1), update(3, 2), update(4, 2)} {
+		if err := dispatcher.Submit(next); err != nil {
+			t.Fatal(err)
+		}
+	}
+	eventually(t, "other chat waits for the slow chat", func() bool {
+		return len(r.handledOf(2)) == 2
+	})
+	if handled := r.handledOf(1); len(handled) != 0 {
+		t.Fatalf("updates %v of the slow chat are handled while the first one is not", handled)
+	}
+	close(slow)
+	drain(t, dispatcher)
+	if handled := r.handledOf(1); len(handled) != 2 || handled[0] != 1 || handled[1] != 2 {
+		t.Errorf("slow chat handled %v, expected [1 2]", handled)
+	}
+}
+
+func TestOffsetDoesNotPassUnfinishedUpdate(t *testing.T) {
+	var r = newRecorder()
+	var dispatcher = NewDispatcher(configuration.Dispatcher{Workers: 3, QueueSize: 8}, r.handle, r.commit)
+	var second = r.hold(2)
+	for _, next := range []models.Update{update(1, 1), update(2, 2), update(3, 3), update(4, 1)} {
+		if err := dispatcher.Submit(next); err != nil {
+			t.Fatal(err)
+		}
+	}
+	eventually(t, "updates around the unfinished one are not handled", func() bool {
+		return len(r.handledOf(1)) == 2 && len(r.handledOf(3)) == 1 && r.lastCommitted() >= 1
+	})
+	if last := r.lastCommitted(); last != 1 {
+		t.Fatalf("Committed %d while update 2 is handled, expected 1", last)
+	}
+	close(second)
+	drain(t, dispatcher)
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	for index, committed := range r.committed {
+		if index > 0 && committed <= r.committed[index-1] {
+			t.Fatalf("Offsets are committed out of order: %v", r.committed)
+		}
+	}
+	if last := r.committed[len(r.committed)-1]; last != 4 {
+		t.Errorf("Committed %v, expected 4 at last", r.committed)
+	}
+}
+
+func TestDrainUnblocksSubmit(t *testing.T) {
+	var r = newRecorder()
+	var dispatcher = NewDispatcher(configuration.Dispatcher{Workers: 1, QueueSize: 1}, r.handle, r.commit)
+	var first = r.hold(1)
+	if err := dispatcher.Submit(update(1, 1)); err != nil {
+		t.Fatal(err)
+	}
+	r.waitStarted(t, 1)
+	//The worker is busy, the second update fills the queue and the third one waits for a place
+	if err := dispatcher.Submit(update(2, 2)); err != nil {
+		t.Fatal(err)
+	}
+	var submitted = make(chan error, 1)
+	go func() {
+		submitted <- dispatcher.Submit(update(3, 3))
+	}()
+	eventually(t, "Submit does not block on the full queue", func() bool {
+		return dispatcher.Stats().Blocked == 1
+	})
+
+	var ctx, cancel = context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	var err = dispatcher.Drain(ctx)
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("Drain with the handler stuck returns %v, expected deadline error", err)
+	}
+	select {
+	case err := <-submitted:
+		if !errors.Is(err, ErrStopped) {
+			t.Errorf("Blocked Submit returns %v after Drain, expected ErrStopped", err)
+		}
+	case <-time.After(timeout):
+		t.Fatal("Submit is still blocked after Drain")
+	}
+	if err := dispatcher.Submit(update(4, 4)); !errors.Is(err, ErrStopped) {
+		t.Errorf("Submit after Drain returns %v, expected ErrStopped", err)
+	}
+
+	close(first)
+	drain(t, dispatcher)
+	if handled := r.handledOf(2); len(handled) != 1 {
+		t.Error("Queued update is not handled by Drain")
+	}
+	if handled := r.handledOf(3); len(handled) != 0 {
+		t.Error("Update rejected by Submit is handled")
+	}
+}
+
+func TestPanicsAreCounted(t *testing.T) {
+	var r = newRecorder()
+	r.panicAt[2] = true
+	var dispatcher = NewDispatcher(configuration.Dispatcher{Workers: 1, QueueSize: 8}, r.handle, r.commit)
+	for id := 1; id <= 3; id++ {
+		if err := dispatcher.Submit(update(id, 1)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	drain(t, dispatcher)
+	var stats = dispatcher.Stats()
+	if stats.Panics != 1 || stats.Handled != 3 || stats.Submitted != 3 {
+		t.Errorf("Stats after a panic: %s", stats)
+	}
+	if handled := r.handledOf(1); len(handled) != 3 {
+		t.Errorf("Updates after the panic are not handled: %v", handled)
+	}
+	if last := r.lastCommitted(); last != 3 {
+		t.Errorf("Committed %d, the update that panicked is confirmed too, expected 3", last)
+	}
+}
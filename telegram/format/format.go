@@ -0,0 +1,303 @@
+package format
+
+import (
+	"strings"
+	"unicode/utf16"
+)
+
+//Parse modes of Bot API. See https://core.telegram.org/bots/api#formatting-options
+const (
+	ModeMarkdownV2 = "MarkdownV2"
+	ModeHTML       = "HTML"
+)
+
+//MaxMessageLength Telegram limits message text to 4096 characters
+const MaxMessageLength = 4096
+
+//markdownSpecial characters escaped in MarkdownV2 text, code escapes only ` and \, link url only ) and \
+const markdownSpecial = "_*[]()~`>#+-=|{}.!\\"
+
+var htmlEscaper = strings.NewReplacer("&", "&amp;", "<", "&lt;", ">", "&gt;", `"`, "&quot;")
+
+//EscapeMarkdownV2 text shown as is in MarkdownV2 message
+func EscapeMarkdownV2(text string) string {
+	return escape(text, markdownSpecial)
+}
+
+//EscapeHTML text shown as is in HTML message
+func EscapeHTML(text string) string {
+	return htmlEscaper.Replace(text)
+}
+
+func escape(text, special string) string {
+	var escaped = new(strings.Builder)
+	for _, r := range text {
+		if strings.ContainsRune(special, r) {
+			escaped.WriteRune('\\')
+		}
+		escaped.WriteRune(r)
+	}
+	return escaped.String()
+}
+
+//Kinds of segments
+const (
+	plain = iota
+	bold
+	italic
+	code
+	pre
+	link
+)
+
+//segment text of one kind, links keep url and pre blocks their language in extra
+type segment struct {
+	kind  int
+	text  string
+	extra string
+}
+
+//Builder composes message text from plain text and entities, escaping them for the parse mode.
+//It keeps the parts, so long text is split between them without breaking entities
+type Builder struct {
+	mode     string
+	segments []segment
+	fileName string
+}
+
+//Text builds text without markup
+func Text(text string) *Builder {
+	return new(Builder).Text(text)
+}
+
+//Markdown builds MarkdownV2 text
+func Markdown() *Builder {
+	return &Builder{mode: ModeMarkdownV2}
+}
+
+//HTML builds HTML text
+func HTML() *Builder {
+	return &Builder{mode: ModeHTML}
+}
+
+func (builder *Builder) add(kind int, text, extra string) *Builder {
+	if text != "" {
+		builder.segments = append(builder.segments, segment{kind: kind, text: text, extra: extra})
+	}
+	return builder
+}
+
+//Text adds plain text, special characters are escaped
+func (builder *Builder) Text(text string) *Builder {
+	return builder.add(plain, text, "")
+}
+
+//Line adds text followed by line break
+func (builder *Builder) Line(text string) *Builder {
+	return builder.add(plain, text+"\n", "")
+}
+
+func (builder *Builder) Bold(text string) *Builder {
+	return builder.add(bold, text, "")
+}
+
+func (builder *Builder) Italic(text string) *Builder {
+	return builder.add(italic, text, "")
+}
+
+//Code adds inline monospace text, e.g. gid or command
+func (builder *Builder) Code(text string) *Builder {
+	return builder.add(code, text, "")
+}
+
+//Pre adds preformatted block, language may be empty
+func (builder *Builder) Pre(text, language string) *Builder {
+	return builder.add(pre, text, language)
+}
+
+func (builder *Builder) Link(text, url string) *Builder {
+	return builder.add(link, text, url)
+}
+
+//FileName name of .txt document the text is sent as when it is too long, "message.txt" if not set
+func (builder *Builder) FileName(name string) *Builder {
+	builder.fileName = name
+	return builder
+}
+
+//ParseMode of the text, empty for text without markup
+func (builder *Builder) ParseMode() string {
+	return builder.mode
+}
+
+//Empty nothing is added
+func (builder *Builder) Empty() bool {
+	return len(builder.segments) == 0
+}
+
+//String text with markup of the parse mode
+func (builder *Builder) String() string {
+	var text = new(strings.Builder)
+	for _, part := range builder.segments {
+		text.WriteString(builder.render(part))
+	}
+	return text.String()
+}
+
+//Plain text without markup, links are followed by their url
+func (builder *Builder) Plain() string {
+	var text = new(strings.Builder)
+	for _, part := range builder.segments {
+		text.WriteString(renderPlain(part))
+	}
+	return text.String()
+}
+
+func (builder *Builder) render(part segment) string {
+	switch builder.mode {
+	case ModeHTML:
+		return renderHTML(part)
+	case ModeMarkdownV2:
+		return renderMarkdown(part)
+	}
+	return renderPlain(part)
+}
+
+func renderPlain(part segment) string {
+	if part.kind == link {
+		return part.text + " (" + part.extra + ")"
+	}
+	return part.text
+}
+
+func renderMarkdown(part segment) string {
+	switch part.kind {
+	case bold:
+		return "*" + EscapeMarkdownV2(part.text) + "*"
+	case italic:
+		return "_" + EscapeMarkdownV2(part.text) + "_"
+	case code:
+		return "`" + escape(part.text, "`\\") + "`"
+	case pre:
+		return "```" + part.extra + "\n" + escape(part.text, "`\\") + "\n```"
+	case link:
+		return "[" + EscapeMarkdownV2(part.text) + "](" + escape(part.extra, ")\\") + ")"
+	}
+	return EscapeMarkdownV2(part.text)
+}
+
+func renderHTML(part segment) string {
+	switch part.kind {
+	case bold:
+		return "<b>" + EscapeHTML(part.text) + "</b>"
+	case italic:
+		return "<i>" + EscapeHTML(part.text) + "</i>"
+	case code:
+		return "<code>" + EscapeHTML(part.text) + "</code>"
+	case pre:
+		if part.extra != "" {
+			return `<pre><code class="language-` + EscapeHTML(part.extra) + `">` + EscapeHTML(part.text) + "</code></pre>"
+		}
+		return "<pre>" + EscapeHTML(part.text) + "</pre>"
+	case link:
+		return `<a href="` + EscapeHTML(part.extra) + `">` + EscapeHTML(part.text) + "</a>"
+	}
+	return EscapeHTML(part.text)
+}
+
+//length in UTF-16 code units, as Telegram counts message length
+func length(text string) int {
+	return len(utf16.Encode([]rune(text)))
+}
+
+//Split renders the text in parts no longer than limit with markup
+func (builder *Builder) Split(limit int) []string {
+	var parts []string
+	for _, part := range builder.split(limit) {
+		parts = append(parts, part.String())
+	}
+	return parts
+}
+
+//split divides segments between parts. A segment longer than the limit is split at line breaks or spaces
+//and every piece keeps its entity
+func (builder *Builder) split(limit int) []*Builder {
+	var parts []*Builder
+	var current = &Builder{mode: builder.mode}
+	var size = 0
+	var flush = func() {
+		if strings.TrimSpace(current.Plain()) != "" {
+			parts = append(parts, current)
+		}
+		current = &Builder{mode: builder.mode}
+		size = 0
+	}
+	for _, part := range builder.segments {
+		var rest = part
+		for rest.text != "" {
+			var renderedSize = length(builder.render(rest))
+			if size+renderedSize <= limit {
+				current.segments = append(current.segments, rest)
+				size += renderedSize
+				break
+			}
+			//Segment fitting into an empty part is not split
+			if size > 0 && renderedSize <= limit {
+				flush()
+				continue
+			}
+			var head, tail = builder.cut(rest, limit-size)
+			if head.text == "" && size > 0 {
+				flush()
+				continue
+			}
+			if head.text == "" {
+				//The limit is shorter than the markup, a character is sent anyway
+				var runes = []rune(rest.text)
+				head.text, tail.text = string(runes[:1]), string(runes[1:])
+			}
+			current.segments = append(current.segments, head)
+			flush()
+			rest = tail
+		}
+	}
+	flush()
+	return parts
+}
+
+//cut takes the longest beginning of the segment fitting into limit with markup, preferably ending at line break or space
+func (builder *Builder) cut(part segment, limit int) (segment, segment) {
+	var runes = []rune(part.text)
+	var fits = func(count int) bool {
+		var head = part
+		head.text = string(runes[:count])
+		return length(builder.render(head)) <= limit
+	}
+	var low, high = 0, len(runes)
+	for low < high {
+		var middle = (low + high + 1) / 2
+		if fits(middle) {
+			low = middle
+		} else {
+			high = middle - 1
+		}
+	}
+	if low == 0 {
+		var tail = part
+		return segment{kind: part.kind, extra: part.extra}, tail
+	}
+	var count = low
+	if count < len(runes) {
+		var text = string(runes[:count])
+		for _, separator := range []string{"\n", " "} {
+			if index := strings.LastIndex(text, separator); index > len(text)/2 {
+				count = len([]rune(text[:index+1]))
+				break
+			}
+		}
+	}
+	var head, tail = part, part
+	head.text = string(runes[:count])
+	tail.text = string(runes[count:])
+	return head, tail
+}
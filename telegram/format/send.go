@@ -0,0 +1,69 @@
+package format
+
+import (
+	"bitbucket.org/y4cxp543/telegram-bot/interfaces"
+	"bitbucket.org/y4cxp543/telegram-bot/telegram/models"
+	"context"
+	"errors"
+	"net/http"
+	"strings"
+)
+
+//MaxMessages text taking more messages is sent as .txt document
+const MaxMessages = 3
+
+const defaultFileName = "message.txt"
+
+//Reply sends the text as a reply, split into messages of MaxMessageLength.
+//Text longer than MaxMessages messages is sent as .txt document, text Telegram cannot parse is sent without markup
+func Reply(ctx context.Context, tFunctions interfaces.ITelegramFunctions, chatId int64, replyToMessageId int, text *Builder) error {
+	var parts = text.split(MaxMessageLength)
+	if len(parts) > MaxMessages {
+		return sendDocument(ctx, tFunctions, chatId, replyToMessageId, text)
+	}
+	var parseMode = text.ParseMode()
+	for _, part := range parts {
+		part.mode = parseMode
+		var err = send(ctx, tFunctions, chatId, replyToMessageId, part.String(), parseMode)
+		if parseMode != "" && parseFailed(err) {
+			//Markup is wrong, this part and the rest are sent as plain text, it is never longer
+			parseMode = ""
+			part.mode = ""
+			err = send(ctx, tFunctions, chatId, replyToMessageId, part.String(), parseMode)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func send(ctx context.Context, tFunctions interfaces.ITelegramFunctions, chatId int64, replyToMessageId int, text, parseMode string) error {
+	_, err := tFunctions.SendMessage(ctx, models.SendMessage{
+		ChatId:           models.NewChatID(chatId),
+		Text:             text,
+		ParseMode:        parseMode,
+		ReplyToMessageId: models.Int(replyToMessageId),
+	})
+	return err
+}
+
+func sendDocument(ctx context.Context, tFunctions interfaces.ITelegramFunctions, chatId int64, replyToMessageId int, text *Builder) error {
+	var fileName = text.fileName
+	if fileName == "" {
+		fileName = defaultFileName
+	}
+	_, err := tFunctions.SendDocument(ctx, models.SendDocument{
+		ChatId:           models.NewChatID(chatId),
+		Document:         models.NewInputFileReader(fileName, strings.NewReader(text.Plain())),
+		ReplyToMessageId: models.Int(replyToMessageId),
+	})
+	return err
+}
+
+//parseFailed Telegram rejected entities of the text
+func parseFailed(err error) bool {
+	var apiError *models.TelegramAPIError
+	return errors.As(err, &apiError) && apiError.ErrorCode == http.StatusBadRequest &&
+		strings.Contains(apiError.Description, "can't parse entities")
+}
@@ -0,0 +1,321 @@
+package inline
+
+import (
+	"bitbucket.org/y4cxp543/telegram-bot/external/torrentz2"
+	"bitbucket.org/y4cxp543/telegram-bot/interfaces"
+	configuration "bitbucket.org/y4cxp543/telegram-bot/models"
+	"bitbucket.org/y4cxp543/telegram-bot/telegram/callback"
+	"bitbucket.org/y4cxp543/telegram-bot/telegram/models"
+	"context"
+	"log"
+	"runtime/debug"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+//Prefix callback_data prefix of the button under messages posted from inline results: "is:d:<link>" downloads the result
+const Prefix = "is"
+
+const downloadAction = "d"
+
+const (
+	DefaultDebounce = 700 * time.Millisecond
+	DefaultTTL      = 10 * time.Minute
+	DefaultPageSize = 20
+	//maxPageSize Telegram accepts no more than 50 results per answer
+	maxPageSize = 50
+	//cacheTime seconds Telegram may show the answer again without asking the bot
+	cacheTime = 60
+	//linkTTL names of posted results are kept for their Download buttons
+	linkTTL = 24 * time.Hour
+)
+
+//Searcher finds torrents by the words of the query
+type Searcher func(query string) ([]torrentz2.Result, error)
+
+//Downloader starts download of the result from the button of a posted message and returns notification text
+type Downloader func(arg interfaces.CallbackArgument, result torrentz2.Result) (string, error)
+
+type entry struct {
+	results []torrentz2.Result
+	expires time.Time
+}
+
+type name struct {
+	name    string
+	expires time.Time
+}
+
+type pick struct {
+	count   int
+	expires time.Time
+}
+
+//Search answers inline queries "@bot words". Queries are searched when the user stops typing,
+//results are cached per query and given out page by page
+type Search struct {
+	mutex   sync.Mutex
+	results map[string]*entry
+	//pending debounced searches by user, closing the channel drops the search
+	pending map[int64]chan struct{}
+	//names of results by link, buttons of inline messages carry only the link
+	names map[string]name
+	//picked counts of posted results, forgotten linkTTL after the last post like names
+	picked     map[string]pick
+	tFunctions interfaces.ITelegramFunctions
+	clock      interfaces.Clock
+	search     Searcher
+	download   Downloader
+	debounce   time.Duration
+	ttl        time.Duration
+	pageSize   int
+}
+
+//NewSearch zero settings use DefaultDebounce, DefaultTTL and DefaultPageSize
+func NewSearch(config configuration.Inline, tFunctions interfaces.ITelegramFunctions, clock interfaces.Clock, search Searcher, download Downloader) *Search {
+	var debounce, ttl, pageSize = time.Duration(config.Debounce) * time.Millisecond, time.Duration(config.CacheTTL) * time.Second, config.PageSize
+	if debounce <= 0 {
+		debounce = DefaultDebounce
+	}
+	if ttl <= 0 {
+		ttl = DefaultTTL
+	}
+	if pageSize <= 0 {
+		pageSize = DefaultPageSize
+	}
+	if pageSize > maxPageSize {
+		pageSize = maxPageSize
+	}
+	return &Search{
+		results:    make(map[string]*entry),
+		pending:    make(map[int64]chan struct{}),
+		names:      make(map[string]name),
+		picked:     make(map[string]pick),
+		tFunctions: tFunctions,
+		clock:      clock,
+		search:     search,
+		download:   download,
+		debounce:   debounce,
+		ttl:        ttl,
+		pageSize:   pageSize,
+	}
+}
+
+//normalize queries differing only in case and spaces share results
+func normalize(query string) string {
+	return strings.ToLower(strings.Join(strings.Fields(query), " "))
+}
+
+//HandleQuery answers at once from the cache, otherwise searches when no newer query of the user comes
+//during the debounce delay. Telegram drops answers to outdated queries, so they are not answered
+func (search *Search) HandleQuery(ctx context.Context, query *models.InlineQuery) error {
+	var text = normalize(query.Query)
+	if text == "" {
+		return search.answer(ctx, query, nil, 0)
+	}
+	var offset, _ = strconv.Atoi(query.Offset)
+	var userId int64
+	if query.From != nil {
+		userId = query.From.Id
+	}
+	var cancel = make(chan struct{})
+	search.mutex.Lock()
+	if previous, ok := search.pending[userId]; ok {
+		close(previous)
+		delete(search.pending, userId)
+	}
+	var cached, ok = search.cached(text)
+	if !ok && offset == 0 {
+		search.pending[userId] = cancel
+	}
+	search.mutex.Unlock()
+	switch {
+	case ok:
+		return search.answer(ctx, query, cached, offset)
+	case offset > 0:
+		//Next page of expired results, the user scrolls and does not type
+		return search.searchAndAnswer(ctx, query, text, offset)
+	}
+	go search.debounced(ctx, query, text, userId, cancel)
+	return nil
+}
+
+//debounced runs in its own goroutine, a panic there would stop the bot, so it is logged and the query is dropped
+func (search *Search) debounced(ctx context.Context, query *models.InlineQuery, text string, userId int64, cancel chan struct{}) {
+	defer func() {
+		if recovered := recover(); recovered != nil {
+			log.Printf("Panic in inline search %q: %v\n%s", text, recovered, debug.Stack())
+		}
+	}()
+	select {
+	case <-cancel:
+		return
+	case <-ctx.Done():
+		return
+	case <-search.clock.After(search.debounce):
+	}
+	search.mutex.Lock()
+	if search.pending[userId] != cancel {
+		search.mutex.Unlock()
+		return
+	}
+	delete(search.pending, userId)
+	search.mutex.Unlock()
+	if err := search.searchAndAnswer(ctx, query, text, 0); err != nil {
+		log.Println(err)
+	}
+}
+
+func (search *Search) searchAndAnswer(ctx context.Context, query *models.InlineQuery, text string, offset int) error {
+	var results, err = search.search(text)
+	if err != nil {
+		//Empty answer is not cached, so the next query searches again
+		log.Println("Inline search failed: ", err)
+		return search.answer(ctx, query, nil, 0)
+	}
+	search.mutex.Lock()
+	search.removeExpired()
+	search.results[text] = &entry{results: results, expires: search.clock.Now().Add(search.ttl)}
+	search.mutex.Unlock()
+	return search.answer(ctx, query, results, offset)
+}
+
+//cached results of the query, must be called under the mutex
+func (search *Search) cached(text string) ([]torrentz2.Result, bool) {
+	var cached, ok = search.results[text]
+	if !ok || search.clock.Now().After(cached.expires) {
+		return nil, false
+	}
+	return cached.results, true
+}
+
+//removeExpired must be called under the mutex
+func (search *Search) removeExpired() {
+	var now = search.clock.Now()
+	for text, cached := range search.results {
+		if now.After(cached.expires) {
+			delete(search.results, text)
+		}
+	}
+	for link, named := range search.names {
+		if now.After(named.expires) {
+			delete(search.names, link)
+		}
+	}
+	for id, picked := range search.picked {
+		if now.After(picked.expires) {
+			delete(search.picked, id)
+		}
+	}
+}
+
+//answer sends the page of results starting at offset, next_offset asks for the following page when the list is scrolled
+func (search *Search) answer(ctx context.Context, query *models.InlineQuery, results []torrentz2.Result, offset int) error {
+	var request = models.AnswerInlineQuery{
+		InlineQueryId: query.Id,
+		Results:       []models.InlineQueryResult{},
+		//Results depend on the access of the user
+		IsPersonal: models.Bool(true),
+	}
+	if len(results) > 0 {
+		request.CacheTime = models.Int(cacheTime)
+	} else {
+		request.CacheTime = models.Int(0)
+	}
+	if offset < 0 || offset > len(results) {
+		offset = len(results)
+	}
+	var end = offset + search.pageSize
+	if end < len(results) {
+		request.NextOffset = strconv.Itoa(end)
+	} else {
+		end = len(results)
+	}
+	var seen = make(map[string]bool)
+	search.mutex.Lock()
+	var expires = search.clock.Now().Add(linkTTL)
+	for _, result := range results[offset:end] {
+		var article, ok = search.article(result)
+		if !ok || seen[article.Id] {
+			continue
+		}
+		seen[article.Id] = true
+		search.names[article.Id] = name{name: result.Name, expires: expires}
+		request.Results = append(request.Results, article)
+	}
+	search.mutex.Unlock()
+	_, err := search.tFunctions.AnswerInlineQuery(ctx, request)
+	return err
+}
+
+//article shows name, size and age of the result. Its id is the link, so the Download button knows what to download
+func (search *Search) article(result torrentz2.Result) (models.InlineQueryResultArticle, bool) {
+	var link = strings.TrimPrefix(result.Link, "/")
+	var data, err = callback.Encode(Prefix, downloadAction, link)
+	if link == "" || err != nil {
+		log.Println("Result cannot be downloaded from inline message: ", result.Link)
+		return models.InlineQueryResultArticle{}, false
+	}
+	return models.InlineQueryResultArticle{
+		Id:    link,
+		Title: result.Name,
+		InputMessageContent: models.InputTextMessageContent{
+			MessageText: result.Name + "\nSize: " + result.Size + "\nAge: " + result.Age,
+		},
+		ReplyMarkup: &models.InlineKeyboardMarkup{InlineKeyboard: [][]models.InlineKeyboardButton{
+			{{Text: "Download", CallbackData: data}},
+		}},
+		Description: "Size: " + result.Size + ", age: " + result.Age,
+	}, true
+}
+
+//HandleChosen counts results posted by users. Telegram sends them only if inline feedback is enabled in @BotFather
+func (search *Search) HandleChosen(ctx context.Context, chosen *models.ChosenInlineResult) error {
+	search.mutex.Lock()
+	search.removeExpired()
+	var expires = search.clock.Now().Add(linkTTL)
+	var picked = search.picked[chosen.ResultId]
+	picked.count++
+	picked.expires = expires
+	search.picked[chosen.ResultId] = picked
+	var named, ok = search.names[chosen.ResultId]
+	if ok {
+		named.expires = expires
+		search.names[chosen.ResultId] = named
+	}
+	search.mutex.Unlock()
+	var userId int64
+	if chosen.From != nil {
+		userId = chosen.From.Id
+	}
+	log.Printf("Inline result %s %q picked by user %d for query %q, %d times",
+		chosen.ResultId, named.name, userId, chosen.Query, picked.count)
+	return nil
+}
+
+//Picked how many times every result was posted, by result id. Results not posted for linkTTL are not counted
+func (search *Search) Picked() map[string]int {
+	search.mutex.Lock()
+	defer search.mutex.Unlock()
+	search.removeExpired()
+	var picked = make(map[string]int, len(search.picked))
+	for id, counted := range search.picked {
+		picked[id] = counted.count
+	}
+	return picked
+}
+
+//HandleCallback handles Download buttons of posted results, register it for Prefix
+func (search *Search) HandleCallback(arg interfaces.CallbackArgument) (string, error) {
+	if len(arg.Args) != 2 || arg.Args[0] != downloadAction || arg.Args[1] == "" {
+		return "", callback.ErrExpired
+	}
+	var link = arg.Args[1]
+	search.mutex.Lock()
+	var named = search.names[link]
+	search.mutex.Unlock()
+	//The name is forgotten after restart, the magnet link of the detail page has its own
+	return search.download(arg, torrentz2.Result{Link: "/" + link, Name: named.name})
+}
@@ -0,0 +1,14 @@
+package limiter
+
+import "time"
+
+//SystemClock interfaces.Clock backed by the time package
+type SystemClock struct{}
+
+func (SystemClock) Now() time.Time {
+	return time.Now()
+}
+
+func (SystemClock) After(d time.Duration) <-chan time.Time {
+	return time.After(d)
+}
@@ -0,0 +1,226 @@
+package limiter
+
+import (
+	"bitbucket.org/y4cxp543/telegram-bot/interfaces"
+	"bitbucket.org/y4cxp543/telegram-bot/telegram/models"
+	"context"
+	"sync"
+	"time"
+)
+
+//flushPollInterval how often Flush checks the queues
+const flushPollInterval = 50 * time.Millisecond
+
+//Limits of outgoing messages. See https://core.telegram.org/bots/faq#my-bot-is-hitting-limits-how-do-i-avoid-this
+type Limits struct {
+	GlobalPerSecond int
+	ChatPerSecond   int
+	GroupPerMinute  int
+}
+
+var DefaultLimits = Limits{
+	GlobalPerSecond: 30,
+	ChatPerSecond:   1,
+	GroupPerMinute:  20,
+}
+
+//Scheduler queues SendMessage and SendPoll per chat and sends them respecting per chat and global limits.
+//Messages of one chat are sent in order, other calls go straight to the wrapped functions
+type Scheduler struct {
+	interfaces.ITelegramFunctions
+	limits Limits
+	clock  interfaces.Clock
+
+	mutex  sync.Mutex
+	global *tokenBucket
+	chats  map[models.ChatID]*chatQueue
+}
+
+type chatQueue struct {
+	jobs   []*job
+	second *tokenBucket
+	minute *tokenBucket //only for groups
+}
+
+type job struct {
+	ctx  context.Context
+	send func() (models.Message, error)
+	done chan result
+}
+
+type result struct {
+	message models.Message
+	err     error
+}
+
+func NewScheduler(tFunctions interfaces.ITelegramFunctions, limits Limits, clock interfaces.Clock) *Scheduler {
+	return &Scheduler{
+		ITelegramFunctions: tFunctions,
+		limits:             limits,
+		clock:              clock,
+		global:             newTokenBucket(limits.GlobalPerSecond, time.Second, clock.Now()),
+		chats:              make(map[models.ChatID]*chatQueue),
+	}
+}
+
+func (s *Scheduler) SendMessage(ctx context.Context, request models.SendMessage) (models.Message, error) {
+	return s.schedule(ctx, request.ChatId, func() (models.Message, error) {
+		return s.ITelegramFunctions.SendMessage(ctx, request)
+	})
+}
+
+func (s *Scheduler) SendPoll(ctx context.Context, poll models.SendPoll) (models.Message, error) {
+	return s.schedule(ctx, poll.ChatId, func() (models.Message, error) {
+		return s.ITelegramFunctions.SendPoll(ctx, poll)
+	})
+}
+
+//QueueDepth returns number of messages waiting for the chat, including the one being sent
+func (s *Scheduler) QueueDepth(chatId models.ChatID) int {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	if queue, ok := s.chats[chatId]; ok {
+		return len(queue.jobs)
+	}
+	return 0
+}
+
+//TotalQueueDepth returns number of messages waiting for all chats
+func (s *Scheduler) TotalQueueDepth() int {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	var total = 0
+	for _, queue := range s.chats {
+		total += len(queue.jobs)
+	}
+	return total
+}
+
+//Flush waits until the queued messages are sent or the context is done
+func (s *Scheduler) Flush(ctx context.Context) error {
+	var ticker = time.NewTicker(flushPollInterval)
+	defer ticker.Stop()
+	for s.TotalQueueDepth() > 0 {
+		select {
+		case <-ticker.C:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+	return nil
+}
+
+//schedule blocks until the message is sent or the context is done, then the message is dropped from the queue
+func (s *Scheduler) schedule(ctx context.Context, chatId models.ChatID, send func() (models.Message, error)) (models.Message, error) {
+	var j = &job{ctx: ctx, send: send, done: make(chan result, 1)}
+	s.mutex.Lock()
+	var queue, exists = s.chats[chatId]
+	if !exists {
+		s.sweep()
+		queue = s.newChatQueue(chatId)
+		s.chats[chatId] = queue
+	}
+	queue.jobs = append(queue.jobs, j)
+	if len(queue.jobs) == 1 {
+		go s.work(chatId, queue)
+	}
+	s.mutex.Unlock()
+
+	select {
+	case r := <-j.done:
+		return r.message, r.err
+	case <-ctx.Done():
+		return models.Message{}, ctx.Err()
+	}
+}
+
+func (s *Scheduler) newChatQueue(chatId models.ChatID) *chatQueue {
+	var now = s.clock.Now()
+	var queue = &chatQueue{second: newTokenBucket(s.limits.ChatPerSecond, time.Second, now)}
+	//Group and channel ids are negative, usernames are used by channels only
+	if chatId.Id < 0 || chatId.IsUsername() {
+		queue.minute = newTokenBucket(s.limits.GroupPerMinute, time.Minute, now)
+	}
+	return queue
+}
+
+//work sends queued messages of one chat until the queue is empty
+func (s *Scheduler) work(chatId models.ChatID, queue *chatQueue) {
+	for {
+		s.mutex.Lock()
+		var j = queue.jobs[0]
+		var wait time.Duration
+		//Cancelled messages do not take tokens
+		if j.ctx.Err() == nil {
+			wait = s.reserve(queue)
+		}
+		s.mutex.Unlock()
+		s.sleep(j, wait)
+
+		//The global token is taken when the chat may send, so it is counted at the time the message is sent
+		if j.ctx.Err() == nil {
+			s.mutex.Lock()
+			wait = s.global.reserve(s.clock.Now())
+			s.mutex.Unlock()
+			s.sleep(j, wait)
+		}
+		if err := j.ctx.Err(); err != nil {
+			j.done <- result{err: err}
+		} else {
+			var message, err = j.send()
+			j.done <- result{message: message, err: err}
+		}
+
+		s.mutex.Lock()
+		queue.jobs = queue.jobs[1:]
+		if len(queue.jobs) == 0 {
+			//Keep the buckets while they are refilling, otherwise the limit could be bypassed
+			if s.idle(queue) {
+				delete(s.chats, chatId)
+			}
+			s.mutex.Unlock()
+			return
+		}
+		s.mutex.Unlock()
+	}
+}
+
+//reserve takes tokens from chat buckets and returns the longest wait. Must be called under mutex
+func (s *Scheduler) reserve(queue *chatQueue) time.Duration {
+	var now = s.clock.Now()
+	var wait = queue.second.reserve(now)
+	if queue.minute != nil {
+		if minuteWait := queue.minute.reserve(now); minuteWait > wait {
+			wait = minuteWait
+		}
+	}
+	return wait
+}
+
+//sleep waits until the delay passes or the message is cancelled
+func (s *Scheduler) sleep(j *job, delay time.Duration) {
+	if delay <= 0 {
+		return
+	}
+	select {
+	case <-s.clock.After(delay):
+	case <-j.ctx.Done():
+	}
+}
+
+//sweep forgets empty queues with refilled buckets. Must be called under mutex
+func (s *Scheduler) sweep() {
+	for chatId, queue := range s.chats {
+		if len(queue.jobs) == 0 && s.idle(queue) {
+			delete(s.chats, chatId)
+		}
+	}
+}
+
+func (s *Scheduler) idle(queue *chatQueue) bool {
+	var now = s.clock.Now()
+	if queue.second.refilled(now) && (queue.minute == nil || queue.minute.refilled(now)) {
+		return true
+	}
+	return false
+}
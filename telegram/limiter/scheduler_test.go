@@ -0,0 +1,186 @@
+package limiter
+
+import (
+	"bitbucket.org/y4cxp543/telegram-bot/interfaces"
+	"bitbucket.org/y4cxp543/telegram-bot/telegram/models"
+	"context"
+	"sync"
+	"testing"
+	"time"
+)
+
+var start = time.Date(2021, 6, 1, 12, 0, 0, 0, time.UTC)
+
+type timer struct {
+	at    time.Time
+	fired chan time.Time
+}
+
+//fakeClock time moves only by Advance
+type fakeClock struct {
+	mutex  sync.Mutex
+	now    time.Time
+	timers []timer
+}
+
+func (clock *fakeClock) Now() time.Time {
+	clock.mutex.Lock()
+	defer clock.mutex.Unlock()
+	return clock.now
+}
+
+func (clock *fakeClock) After(d time.Duration) <-chan time.Time {
+	clock.mutex.Lock()
+	defer clock.mutex.Unlock()
+	var fired = make(chan time.Time, 1)
+	clock.timers = append(clock.timers, timer{at: clock.now.Add(d), fired: fired})
+	return fired
+}
+
+func (clock *fakeClock) Advance(d time.Duration) {
+	clock.mutex.Lock()
+	defer clock.mutex.Unlock()
+	clock.now = clock.now.Add(d)
+	var pending []timer
+	for _, t := range clock.timers {
+		if t.at.After(clock.now) {
+			pending = append(pending, t)
+		} else {
+			t.fired <- clock.now
+		}
+	}
+	clock.timers = pending
+}
+
+func (clock *fakeClock) Timers() int {
+	clock.mutex.Lock()
+	defer clock.mutex.Unlock()
+	return len(clock.timers)
+}
+
+type sent struct {
+	chatId int64
+	text   string
+	at     time.Time
+}
+
+//fakeFunctions records sent messages. Sending blocks while gate is open and not closed
+type fakeFunctions struct {
+	interfaces.ITelegramFunctions
+	clock *fakeClock
+	gate  chan struct{}
+	mutex sync.Mutex
+	sent  []sent
+}
+
+func (functions *fakeFunctions) SendMessage(ctx context.Context, request models.SendMessage) (models.Message, error) {
+	if functions.gate != nil {
+		<-functions.gate
+	}
+	functions.mutex.Lock()
+	defer functions.mutex.Unlock()
+	functions.sent = append(functions.sent, sent{chatId: request.ChatId.Id, text: request.Text, at: functions.clock.Now()})
+	return models.Message{}, nil
+}
+
+func (functions *fakeFunctions) Sent() []sent {
+	functions.mutex.Lock()
+	defer functions.mutex.Unlock()
+	return append([]sent(nil), functions.sent...)
+}
+
+func newTestScheduler(limits Limits) (*Scheduler, *fakeFunctions, *fakeClock) {
+	var clock = &fakeClock{now: start}
+	var functions = &fakeFunctions{clock: clock}
+	return NewScheduler(functions, limits, clock), functions, clock
+}
+
+func send(scheduler *Scheduler, chatId int64, text string) {
+	go func() {
+		_, _ = scheduler.SendMessage(context.Background(), models.SendMessage{ChatId: models.NewChatID(chatId), Text: text})
+	}()
+}
+
+//waitFor waits until the scheduler goroutines reach the condition
+func waitFor(t *testing.T, what string, condition func() bool) {
+	t.Helper()
+	var deadline = time.Now().Add(5 * time.Second)
+	for !condition() {
+		if time.Now().After(deadline) {
+			t.Fatal("timed out waiting for ", what)
+		}
+		time.Sleep(time.Millisecond)
+	}
+}
+
+func TestMessagesOfChatAreSentInOrder(t *testing.T) {
+	var scheduler, functions, clock = newTestScheduler(DefaultLimits)
+	functions.gate = make(chan struct{})
+	var texts = []string{"first", "second", "third"}
+	for i, text := range texts {
+		send(scheduler, 1, text)
+		var depth = i + 1
+		waitFor(t, text+" queued", func() bool { return scheduler.QueueDepth(models.NewChatID(1)) == depth })
+	}
+	close(functions.gate)
+	for i := range texts {
+		var count = i + 1
+		waitFor(t, texts[i]+" sent", func() bool { return len(functions.Sent()) == count })
+		if i < len(texts)-1 {
+			waitFor(t, "chat limit wait", func() bool { return clock.Timers() == 1 })
+			clock.Advance(time.Second)
+		}
+	}
+	for i, message := range functions.Sent() {
+		if message.text != texts[i] || !message.at.Equal(start.Add(time.Duration(i)*time.Second)) {
+			t.Fatalf("message %d is %q at %s, expected %q at %s", i, message.text, message.at, texts[i], start.Add(time.Duration(i)*time.Second))
+		}
+	}
+	waitFor(t, "empty queue", func() bool { return scheduler.TotalQueueDepth() == 0 })
+}
+
+func TestGlobalLimitAcrossChats(t *testing.T) {
+	var scheduler, functions, clock = newTestScheduler(Limits{GlobalPerSecond: 3, ChatPerSecond: 1, GroupPerMinute: 20})
+	for chatId := int64(1); chatId <= 5; chatId++ {
+		send(scheduler, chatId, "text")
+	}
+	waitFor(t, "global limit waits", func() bool { return len(functions.Sent()) == 3 && clock.Timers() == 2 })
+	clock.Advance(time.Second / 3)
+	waitFor(t, "fourth message", func() bool { return len(functions.Sent()) == 4 })
+	clock.Advance(time.Second / 3)
+	waitFor(t, "fifth message", func() bool { return len(functions.Sent()) == 5 })
+	var chats = make(map[int64]bool)
+	for _, message := range functions.Sent() {
+		chats[message.chatId] = true
+	}
+	if len(chats) != 5 {
+		t.Fatalf("messages are sent to %d chats, expected 5", len(chats))
+	}
+}
+
+//A group waiting for its per minute limit must not free the global limit for other chats
+func TestGroupLimitDoesNotBypassGlobalLimit(t *testing.T) {
+	const group, first, second = -100, 1, 2
+	var scheduler, functions, clock = newTestScheduler(Limits{GlobalPerSecond: 2, ChatPerSecond: 1, GroupPerMinute: 1})
+	send(scheduler, group, "group 1")
+	waitFor(t, "first group message", func() bool { return len(functions.Sent()) == 1 })
+	send(scheduler, first, "private 1")
+	waitFor(t, "first private message", func() bool { return len(functions.Sent()) == 2 })
+	send(scheduler, group, "group 2")
+	waitFor(t, "group limit wait", func() bool { return clock.Timers() == 1 })
+	send(scheduler, second, "private 2")
+	waitFor(t, "global limit wait", func() bool { return clock.Timers() == 2 || len(functions.Sent()) > 2 })
+	if sentCount := len(functions.Sent()); sentCount != 2 {
+		t.Fatalf("%d messages are sent in the first moment, the global limit is 2", sentCount)
+	}
+	clock.Advance(time.Second / 2)
+	waitFor(t, "second private message", func() bool { return len(functions.Sent()) == 3 })
+	if message := functions.Sent()[2]; message.chatId != second {
+		t.Fatalf("message to chat %d is sent, expected %d", message.chatId, second)
+	}
+	clock.Advance(time.Minute)
+	waitFor(t, "second group message", func() bool { return len(functions.Sent()) == 4 })
+	if message := functions.Sent()[3]; message.chatId != group || message.at.Before(start.Add(time.Minute)) {
+		t.Fatalf("message to chat %d is sent at %s, expected group message a minute after the first", message.chatId, message.at)
+	}
+}
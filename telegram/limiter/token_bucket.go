@@ -0,0 +1,45 @@
+package limiter
+
+import "time"
+
+//tokenBucket refills rate tokens per second up to capacity. Not safe for concurrent use
+type tokenBucket struct {
+	capacity float64
+	rate     float64
+	tokens   float64
+	last     time.Time
+}
+
+func newTokenBucket(capacity int, per time.Duration, now time.Time) *tokenBucket {
+	return &tokenBucket{
+		capacity: float64(capacity),
+		rate:     float64(capacity) / per.Seconds(),
+		tokens:   float64(capacity),
+		last:     now,
+	}
+}
+
+//reserve takes one token and returns how long the caller must wait before using it.
+//Tokens may go negative, so the next callers are queued behind already reserved ones.
+//Tokens are counted at the time of the latest reservation, the wait is returned from now
+func (b *tokenBucket) reserve(now time.Time) time.Duration {
+	if now.After(b.last) {
+		b.tokens += now.Sub(b.last).Seconds() * b.rate
+		if b.tokens > b.capacity {
+			b.tokens = b.capacity
+		}
+		b.last = now
+	}
+	b.tokens--
+	var wait = b.last.Sub(now)
+	if b.tokens < 0 {
+		wait += time.Duration(-b.tokens / b.rate * float64(time.Second))
+	}
+	return wait
+}
+
+//refilled reports whether the bucket is full at the moment
+func (b *tokenBucket) refilled(now time.Time) bool {
+	var tokens = b.tokens + now.Sub(b.last).Seconds()*b.rate
+	return tokens >= b.capacity
+}
@@ -0,0 +1,114 @@
+package middleware
+
+import (
+	"bitbucket.org/y4cxp543/telegram-bot/interfaces"
+	"bitbucket.org/y4cxp543/telegram-bot/util"
+	"errors"
+	"fmt"
+	"log"
+	"os"
+	"runtime/debug"
+	"sync"
+	"time"
+)
+
+//ErrForbidden access control middleware returns it when the user may not run the handler
+var ErrForbidden = errors.New("forbidden")
+
+//ErrPanic handler panicked, recovery middleware returns it wrapped
+var ErrPanic = errors.New("handler panicked")
+
+//Chain middleware applied to every handler, the first one added is the outermost
+type Chain struct {
+	mutex       sync.RWMutex
+	middlewares []interfaces.IMiddleware
+}
+
+//NewChain chain with the middlewares, see Default
+func NewChain(middlewares ...interfaces.IMiddleware) *Chain {
+	return &Chain{middlewares: middlewares}
+}
+
+//Default recovers panics, logs handlers and their duration
+func Default() *Chain {
+	return NewChain(Recovery(), Logging(), Timing())
+}
+
+func (chain *Chain) Use(middlewares ...interfaces.IMiddleware) {
+	chain.mutex.Lock()
+	defer chain.mutex.Unlock()
+	chain.middlewares = append(chain.middlewares, middlewares...)
+}
+
+//Run calls handler wrapped by the chain and then by the local middlewares of the handler
+func (chain *Chain) Run(request *interfaces.HandlerRequest, handler interfaces.IHandler, local ...interfaces.IMiddleware) error {
+	chain.mutex.RLock()
+	var middlewares = append(append([]interfaces.IMiddleware(nil), chain.middlewares...), local...)
+	chain.mutex.RUnlock()
+	for index := len(middlewares) - 1; index >= 0; index-- {
+		handler = middlewares[index](handler)
+	}
+	if request.Id == "" {
+		request.Id = util.Guid()[:8]
+	}
+	return handler(request)
+}
+
+//Recovery turns panic of the handler into error, so one broken handler does not stop receiving updates
+func Recovery() interfaces.IMiddleware {
+	return func(next interfaces.IHandler) interfaces.IHandler {
+		return func(request *interfaces.HandlerRequest) (err error) {
+			defer func() {
+				if recovered := recover(); recovered != nil {
+					log.Printf("Panic in %s %s [%s]: %v\n%s", request.Kind, request.Name, request.Id, recovered, debug.Stack())
+					err = fmt.Errorf("%w: %v", ErrPanic, recovered)
+				}
+			}()
+			return next(request)
+		}
+	}
+}
+
+//Logging gives the request its logger and logs the handler call
+func Logging() interfaces.IMiddleware {
+	return func(next interfaces.IHandler) interfaces.IHandler {
+		return func(request *interfaces.HandlerRequest) error {
+			var prefix = fmt.Sprintf("[%s %s %s chat=%d user=%d] ", request.Id, request.Kind, request.Name, request.ChatId, request.UserId)
+			request.Logger = log.New(os.Stderr, prefix, log.LstdFlags|log.Lmsgprefix)
+			request.Logger.Println("Handling")
+			var err = next(request)
+			if err != nil {
+				request.Logger.Println("Failed: ", err)
+			}
+			return err
+		}
+	}
+}
+
+//Timing logs how long the handler took
+func Timing() interfaces.IMiddleware {
+	return func(next interfaces.IHandler) interfaces.IHandler {
+		return func(request *interfaces.HandlerRequest) error {
+			var start = time.Now()
+			defer func() {
+				log.Printf("%s %s [%s] took %s", request.Kind, request.Name, request.Id, time.Since(start))
+			}()
+			return next(request)
+		}
+	}
+}
+
+//Auth runs the handler only if allowed returns nil, otherwise returns its error wrapped with ErrForbidden
+func Auth(allowed func(request *interfaces.HandlerRequest) error) interfaces.IMiddleware {
+	return func(next interfaces.IHandler) interfaces.IHandler {
+		return func(request *interfaces.HandlerRequest) error {
+			if err := allowed(request); err != nil {
+				if errors.Is(err, ErrForbidden) {
+					return err
+				}
+				return fmt.Errorf("%w: %v", ErrForbidden, err)
+			}
+			return next(request)
+		}
+	}
+}
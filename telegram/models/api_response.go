@@ -1,6 +1,9 @@
 package models
 
-import "encoding/json"
+import (
+	"encoding/json"
+	"strconv"
+)
 
 //APIResponse standard api response
 type APIResponse struct {
@@ -9,4 +12,54 @@ type APIResponse struct {
 	ErrorCode   int                 `json:"error_code"`
 	Description string              `json:"description"`
 	Parameters  *ResponseParameters `json:"parameters"`
-}
\ No newline at end of file
+}
+
+//TelegramAPIError returned when api response has ok=false
+type TelegramAPIError struct {
+	ErrorCode       int
+	Description     string
+	RetryAfter      int //Seconds to wait before repeating the request (flood control)
+	MigrateToChatId int64 //The group has been migrated to a supergroup with this identifier
+}
+
+func NewTelegramAPIError(response APIResponse) *TelegramAPIError {
+	var apiError = &TelegramAPIError{
+		ErrorCode:   response.ErrorCode,
+		Description: response.Description,
+	}
+	if response.Parameters != nil {
+		if response.Parameters.RetryAfter != nil {
+			apiError.RetryAfter = *response.Parameters.RetryAfter
+		}
+		if response.Parameters.MigrateToChatId != nil {
+			apiError.MigrateToChatId = *response.Parameters.MigrateToChatId
+		}
+	}
+	return apiError
+}
+
+func (e *TelegramAPIError) Error() string {
+	var text = "telegram api error " + strconv.Itoa(e.ErrorCode) + ": " + e.Description
+	if e.RetryAfter > 0 {
+		text += ", retry after " + strconv.Itoa(e.RetryAfter) + "s"
+	}
+	if e.MigrateToChatId != 0 {
+		text += ", migrate to chat " + strconv.FormatInt(e.MigrateToChatId, 10)
+	}
+	return text
+}
+
+//MessageOrTrue result of edit methods: the edited Message, or True if the message is not sent by the bot (inline messages)
+type MessageOrTrue struct {
+	Message *Message
+	Ok      bool
+}
+
+func (m *MessageOrTrue) UnmarshalJSON(data []byte) error {
+	if err := json.Unmarshal(data, &m.Ok); err == nil {
+		return nil
+	}
+	m.Message = new(Message)
+	m.Ok = true
+	return json.Unmarshal(data, m.Message)
+}
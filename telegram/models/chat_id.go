@@ -0,0 +1,72 @@
+package models
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+const usernamePrefix = "@"
+
+//ChatID unique identifier for the target chat or username of the target channel (in the format @channelusername).
+//It is sent as a number or as a string, so the Bot API "Integer or String" parameters keep their meaning
+type ChatID struct {
+	Id       int64
+	Username string
+}
+
+//NewChatID chat identified by id
+func NewChatID(id int64) ChatID {
+	return ChatID{Id: id}
+}
+
+//NewChannelUsername channel identified by username, "@" is added when missing
+func NewChannelUsername(username string) ChatID {
+	if !strings.HasPrefix(username, usernamePrefix) {
+		username = usernamePrefix + username
+	}
+	return ChatID{Username: username}
+}
+
+//IsUsername chat is identified by username instead of id
+func (chatId ChatID) IsUsername() bool {
+	return chatId.Username != ""
+}
+
+//IsEmpty neither id nor username is set
+func (chatId ChatID) IsEmpty() bool {
+	return chatId.Id == 0 && chatId.Username == ""
+}
+
+func (chatId ChatID) String() string {
+	if chatId.IsUsername() {
+		return chatId.Username
+	}
+	return strconv.FormatInt(chatId.Id, 10)
+}
+
+func (chatId ChatID) MarshalJSON() ([]byte, error) {
+	if chatId.IsUsername() {
+		return json.Marshal(chatId.Username)
+	}
+	return json.Marshal(chatId.Id)
+}
+
+func (chatId *ChatID) UnmarshalJSON(data []byte) error {
+	var id int64
+	if err := json.Unmarshal(data, &id); err == nil {
+		*chatId = NewChatID(id)
+		return nil
+	}
+	var text string
+	if err := json.Unmarshal(data, &text); err != nil {
+		return fmt.Errorf("chat id must be a number or a string: %s", data)
+	}
+	if id, err := strconv.ParseInt(text, 10, 64); err == nil {
+		*chatId = NewChatID(id)
+		return nil
+	}
+	*chatId = NewChannelUsername(text)
+	return nil
+}
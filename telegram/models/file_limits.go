@@ -0,0 +1,11 @@
+package models
+
+//FileLimits sizes of files the Bot API server accepts, 0 means unlimited
+type FileLimits struct {
+	//Upload largest file the bot may send
+	Upload int64
+	//Download largest file getFile lets the bot download
+	Download int64
+	//Local the server runs with --local: getFile returns paths on its disk and files on disk are sent as file:// URIs
+	Local bool
+}
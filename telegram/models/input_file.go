@@ -0,0 +1,107 @@
+package models
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+)
+
+const attachPrefix = "attach://"
+
+//InputFile file to send. Files Telegram already has are sent by file_id or HTTP URL,
+//new ones are uploaded using multipart/form-data from disk or from io.Reader
+type InputFile struct {
+	FileId string
+	upload *fileUpload
+}
+
+//fileUpload is shared by copies of InputFile, so the request can be attached after it is built
+type fileUpload struct {
+	name, path string
+	reader     io.Reader
+	attachName string
+}
+
+//NewInputFileId file_id of the file stored on Telegram servers or HTTP URL to get the file from
+func NewInputFileId(fileIdOrUrl string) InputFile {
+	return InputFile{FileId: fileIdOrUrl}
+}
+
+//NewInputFilePath file on disk to upload. It is opened when the request is sent
+func NewInputFilePath(path string) InputFile {
+	return InputFile{upload: &fileUpload{name: filepath.Base(path), path: path}}
+}
+
+//NewInputFileReader content to upload under the name. The reader is read once, so the request cannot be repeated
+func NewInputFileReader(name string, reader io.Reader) InputFile {
+	return InputFile{upload: &fileUpload{name: name, reader: reader}}
+}
+
+//IsUpload file must be sent as a part of multipart/form-data
+func (file InputFile) IsUpload() bool {
+	return file.upload != nil
+}
+
+//FileName name of the uploaded file
+func (file InputFile) FileName() string {
+	if file.upload == nil {
+		return ""
+	}
+	return file.upload.name
+}
+
+//Replayable upload can be read again when the request is repeated
+func (file InputFile) Replayable() bool {
+	return file.upload == nil || file.upload.reader == nil
+}
+
+//Attach names multipart part of the upload. The file is referenced as attach://<name> in the request
+func (file InputFile) Attach(name string) {
+	if file.upload != nil {
+		file.upload.attachName = name
+	}
+}
+
+//AttachName name of multipart part, empty until the file is attached
+func (file InputFile) AttachName() string {
+	if file.upload == nil {
+		return ""
+	}
+	return file.upload.attachName
+}
+
+//Open returns content of the upload, the caller closes it
+func (file InputFile) Open() (io.ReadCloser, error) {
+	switch {
+	case file.upload == nil:
+		return nil, errors.New("file " + file.FileId + " is not an upload")
+	case file.upload.reader != nil:
+		if closer, ok := file.upload.reader.(io.ReadCloser); ok {
+			return closer, nil
+		}
+		return ioutil.NopCloser(file.upload.reader), nil
+	}
+	return os.Open(file.upload.path)
+}
+
+func (file InputFile) MarshalJSON() ([]byte, error) {
+	if file.upload == nil {
+		return json.Marshal(file.FileId)
+	}
+	if file.upload.attachName == "" {
+		return nil, errors.New("file " + file.upload.name + " is not attached to multipart request")
+	}
+	return json.Marshal(attachPrefix + file.upload.attachName)
+}
+
+func (file *InputFile) UnmarshalJSON(data []byte) error {
+	var fileId string
+	if err := json.Unmarshal(data, &fileId); err != nil {
+		return err
+	}
+	*file = NewInputFileId(fileId)
+	return nil
+}
@@ -0,0 +1,18 @@
+package models
+
+//Optional parameters are pointers, so zero values are sent only when they are set explicitly
+
+//Bool pointer to the value of optional boolean parameter
+func Bool(value bool) *bool {
+	return &value
+}
+
+//Int pointer to the value of optional integer parameter
+func Int(value int) *int {
+	return &value
+}
+
+//Int64 pointer to the value of optional identifier parameter
+func Int64(value int64) *int64 {
+	return &value
+}
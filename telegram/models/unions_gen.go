@@ -0,0 +1,1459 @@
+package models
+
+//Generated file
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+//InlineQueryResult This object represents one result of an inline query.
+type InlineQueryResult interface {
+	InlineQueryResultType() string
+}
+
+//InlineQueryResultType type of InlineQueryResultCachedAudio
+func (InlineQueryResultCachedAudio) InlineQueryResultType() string {
+	return "audio"
+}
+
+//MarshalJSON fills type, so it may be left empty
+func (value InlineQueryResultCachedAudio) MarshalJSON() ([]byte, error) {
+	type plain InlineQueryResultCachedAudio
+	value.Type = "audio"
+	return json.Marshal(plain(value))
+}
+
+//InlineQueryResultType type of InlineQueryResultCachedDocument
+func (InlineQueryResultCachedDocument) InlineQueryResultType() string {
+	return "document"
+}
+
+//MarshalJSON fills type, so it may be left empty
+func (value InlineQueryResultCachedDocument) MarshalJSON() ([]byte, error) {
+	type plain InlineQueryResultCachedDocument
+	value.Type = "document"
+	return json.Marshal(plain(value))
+}
+
+//InlineQueryResultType type of InlineQueryResultCachedGif
+func (InlineQueryResultCachedGif) InlineQueryResultType() string {
+	return "gif"
+}
+
+//MarshalJSON fills type, so it may be left empty
+func (value InlineQueryResultCachedGif) MarshalJSON() ([]byte, error) {
+	type plain InlineQueryResultCachedGif
+	value.Type = "gif"
+	return json.Marshal(plain(value))
+}
+
+//InlineQueryResultType type of InlineQueryResultCachedMpeg4Gif
+func (InlineQueryResultCachedMpeg4Gif) InlineQueryResultType() string {
+	return "mpeg4_gif"
+}
+
+//MarshalJSON fills type, so it may be left empty
+func (value InlineQueryResultCachedMpeg4Gif) MarshalJSON() ([]byte, error) {
+	type plain InlineQueryResultCachedMpeg4Gif
+	value.Type = "mpeg4_gif"
+	return json.Marshal(plain(value))
+}
+
+//InlineQueryResultType type of InlineQueryResultCachedPhoto
+func (InlineQueryResultCachedPhoto) InlineQueryResultType() string {
+	return "photo"
+}
+
+//MarshalJSON fills type, so it may be left empty
+func (value InlineQueryResultCachedPhoto) MarshalJSON() ([]byte, error) {
+	type plain InlineQueryResultCachedPhoto
+	value.Type = "photo"
+	return json.Marshal(plain(value))
+}
+
+//InlineQueryResultType type of InlineQueryResultCachedSticker
+func (InlineQueryResultCachedSticker) InlineQueryResultType() string {
+	return "sticker"
+}
+
+//MarshalJSON fills type, so it may be left empty
+func (value InlineQueryResultCachedSticker) MarshalJSON() ([]byte, error) {
+	type plain InlineQueryResultCachedSticker
+	value.Type = "sticker"
+	return json.Marshal(plain(value))
+}
+
+//InlineQueryResultType type of InlineQueryResultCachedVideo
+func (InlineQueryResultCachedVideo) InlineQueryResultType() string {
+	return "video"
+}
+
+//MarshalJSON fills type, so it may be left empty
+func (value InlineQueryResultCachedVideo) MarshalJSON() ([]byte, error) {
+	type plain InlineQueryResultCachedVideo
+	value.Type = "video"
+	return json.Marshal(plain(value))
+}
+
+//InlineQueryResultType type of InlineQueryResultCachedVoice
+func (InlineQueryResultCachedVoice) InlineQueryResultType() string {
+	return "voice"
+}
+
+//MarshalJSON fills type, so it may be left empty
+func (value InlineQueryResultCachedVoice) MarshalJSON() ([]byte, error) {
+	type plain InlineQueryResultCachedVoice
+	value.Type = "voice"
+	return json.Marshal(plain(value))
+}
+
+//InlineQueryResultType type of InlineQueryResultArticle
+func (InlineQueryResultArticle) InlineQueryResultType() string {
+	return "article"
+}
+
+//MarshalJSON fills type, so it may be left empty
+func (value InlineQueryResultArticle) MarshalJSON() ([]byte, error) {
+	type plain InlineQueryResultArticle
+	value.Type = "article"
+	return json.Marshal(plain(value))
+}
+
+//InlineQueryResultType type of InlineQueryResultAudio
+func (InlineQueryResultAudio) InlineQueryResultType() string {
+	return "audio"
+}
+
+//MarshalJSON fills type, so it may be left empty
+func (value InlineQueryResultAudio) MarshalJSON() ([]byte, error) {
+	type plain InlineQueryResultAudio
+	value.Type = "audio"
+	return json.Marshal(plain(value))
+}
+
+//InlineQueryResultType type of InlineQueryResultContact
+func (InlineQueryResultContact) InlineQueryResultType() string {
+	return "contact"
+}
+
+//MarshalJSON fills type, so it may be left empty
+func (value InlineQueryResultContact) MarshalJSON() ([]byte, error) {
+	type plain InlineQueryResultContact
+	value.Type = "contact"
+	return json.Marshal(plain(value))
+}
+
+//InlineQueryResultType type of InlineQueryResultGame
+func (InlineQueryResultGame) InlineQueryResultType() string {
+	return "game"
+}
+
+//MarshalJSON fills type, so it may be left empty
+func (value InlineQueryResultGame) MarshalJSON() ([]byte, error) {
+	type plain InlineQueryResultGame
+	value.Type = "game"
+	return json.Marshal(plain(value))
+}
+
+//InlineQueryResultType type of InlineQueryResultDocument
+func (InlineQueryResultDocument) InlineQueryResultType() string {
+	return "document"
+}
+
+//MarshalJSON fills type, so it may be left empty
+func (value InlineQueryResultDocument) MarshalJSON() ([]byte, error) {
+	type plain InlineQueryResultDocument
+	value.Type = "document"
+	return json.Marshal(plain(value))
+}
+
+//InlineQueryResultType type of InlineQueryResultGif
+func (InlineQueryResultGif) InlineQueryResultType() string {
+	return "gif"
+}
+
+//MarshalJSON fills type, so it may be left empty
+func (value InlineQueryResultGif) MarshalJSON() ([]byte, error) {
+	type plain InlineQueryResultGif
+	value.Type = "gif"
+	return json.Marshal(plain(value))
+}
+
+//InlineQueryResultType type of InlineQueryResultLocation
+func (InlineQueryResultLocation) InlineQueryResultType() string {
+	return "location"
+}
+
+//MarshalJSON fills type, so it may be left empty
+func (value InlineQueryResultLocation) MarshalJSON() ([]byte, error) {
+	type plain InlineQueryResultLocation
+	value.Type = "location"
+	return json.Marshal(plain(value))
+}
+
+//InlineQueryResultType type of InlineQueryResultMpeg4Gif
+func (InlineQueryResultMpeg4Gif) InlineQueryResultType() string {
+	return "mpeg4_gif"
+}
+
+//MarshalJSON fills type, so it may be left empty
+func (value InlineQueryResultMpeg4Gif) MarshalJSON() ([]byte, error) {
+	type plain InlineQueryResultMpeg4Gif
+	value.Type = "mpeg4_gif"
+	return json.Marshal(plain(value))
+}
+
+//InlineQueryResultType type of InlineQueryResultPhoto
+func (InlineQueryResultPhoto) InlineQueryResultType() string {
+	return "photo"
+}
+
+//MarshalJSON fills type, so it may be left empty
+func (value InlineQueryResultPhoto) MarshalJSON() ([]byte, error) {
+	type plain InlineQueryResultPhoto
+	value.Type = "photo"
+	return json.Marshal(plain(value))
+}
+
+//InlineQueryResultType type of InlineQueryResultVenue
+func (InlineQueryResultVenue) InlineQueryResultType() string {
+	return "venue"
+}
+
+//MarshalJSON fills type, so it may be left empty
+func (value InlineQueryResultVenue) MarshalJSON() ([]byte, error) {
+	type plain InlineQueryResultVenue
+	value.Type = "venue"
+	return json.Marshal(plain(value))
+}
+
+//InlineQueryResultType type of InlineQueryResultVideo
+func (InlineQueryResultVideo) InlineQueryResultType() string {
+	return "video"
+}
+
+//MarshalJSON fills type, so it may be left empty
+func (value InlineQueryResultVideo) MarshalJSON() ([]byte, error) {
+	type plain InlineQueryResultVideo
+	value.Type = "video"
+	return json.Marshal(plain(value))
+}
+
+//InlineQueryResultType type of InlineQueryResultVoice
+func (InlineQueryResultVoice) InlineQueryResultType() string {
+	return "voice"
+}
+
+//MarshalJSON fills type, so it may be left empty
+func (value InlineQueryResultVoice) MarshalJSON() ([]byte, error) {
+	type plain InlineQueryResultVoice
+	value.Type = "voice"
+	return json.Marshal(plain(value))
+}
+
+//UnmarshalInlineQueryResult decodes the variant chosen by type. null is decoded to nil
+func UnmarshalInlineQueryResult(data []byte) (InlineQueryResult, error) {
+	if isEmptyJSON(data) {
+		return nil, nil
+	}
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return nil, err
+	}
+	var discriminator string
+	_ = json.Unmarshal(fields["type"], &discriminator)
+	switch {
+	case discriminator == "audio" && hasField(fields, "audio_file_id"):
+		var value InlineQueryResultCachedAudio
+		var err = json.Unmarshal(data, &value)
+		return value, err
+	case discriminator == "document" && hasField(fields, "document_file_id"):
+		var value InlineQueryResultCachedDocument
+		var err = json.Unmarshal(data, &value)
+		return value, err
+	case discriminator == "gif" && hasField(fields, "gif_file_id"):
+		var value InlineQueryResultCachedGif
+		var err = json.Unmarshal(data, &value)
+		return value, err
+	case discriminator == "mpeg4_gif" && hasField(fields, "mpeg4_file_id"):
+		var value InlineQueryResultCachedMpeg4Gif
+		var err = json.Unmarshal(data, &value)
+		return value, err
+	case discriminator == "photo" && hasField(fields, "photo_file_id"):
+		var value InlineQueryResultCachedPhoto
+		var err = json.Unmarshal(data, &value)
+		return value, err
+	case discriminator == "sticker":
+		var value InlineQueryResultCachedSticker
+		var err = json.Unmarshal(data, &value)
+		return value, err
+	case discriminator == "video" && hasField(fields, "video_file_id"):
+		var value InlineQueryResultCachedVideo
+		var err = json.Unmarshal(data, &value)
+		return value, err
+	case discriminator == "voice" && hasField(fields, "voice_file_id"):
+		var value InlineQueryResultCachedVoice
+		var err = json.Unmarshal(data, &value)
+		return value, err
+	case discriminator == "article":
+		var value InlineQueryResultArticle
+		var err = json.Unmarshal(data, &value)
+		return value, err
+	case discriminator == "audio":
+		var value InlineQueryResultAudio
+		var err = json.Unmarshal(data, &value)
+		return value, err
+	case discriminator == "contact":
+		var value InlineQueryResultContact
+		var err = json.Unmarshal(data, &value)
+		return value, err
+	case discriminator == "game":
+		var value InlineQueryResultGame
+		var err = json.Unmarshal(data, &value)
+		return value, err
+	case discriminator == "document":
+		var value InlineQueryResultDocument
+		var err = json.Unmarshal(data, &value)
+		return value, err
+	case discriminator == "gif":
+		var value InlineQueryResultGif
+		var err = json.Unmarshal(data, &value)
+		return value, err
+	case discriminator == "location":
+		var value InlineQueryResultLocation
+		var err = json.Unmarshal(data, &value)
+		return value, err
+	case discriminator == "mpeg4_gif":
+		var value InlineQueryResultMpeg4Gif
+		var err = json.Unmarshal(data, &value)
+		return value, err
+	case discriminator == "photo":
+		var value InlineQueryResultPhoto
+		var err = json.Unmarshal(data, &value)
+		return value, err
+	case discriminator == "venue":
+		var value InlineQueryResultVenue
+		var err = json.Unmarshal(data, &value)
+		return value, err
+	case discriminator == "video":
+		var value InlineQueryResultVideo
+		var err = json.Unmarshal(data, &value)
+		return value, err
+	case discriminator == "voice":
+		var value InlineQueryResultVoice
+		var err = json.Unmarshal(data, &value)
+		return value, err
+	}
+	return nil, fmt.Errorf("unknown InlineQueryResult: %s", data)
+}
+
+//UnmarshalInlineQueryResultArray decodes array of InlineQueryResult
+func UnmarshalInlineQueryResultArray(data []byte) ([]InlineQueryResult, error) {
+	if isEmptyJSON(data) {
+		return nil, nil
+	}
+	var items []json.RawMessage
+	if err := json.Unmarshal(data, &items); err != nil {
+		return nil, err
+	}
+	var values = make([]InlineQueryResult, len(items))
+	for i, item := range items {
+		var value, err = UnmarshalInlineQueryResult(item)
+		if err != nil {
+			return nil, err
+		}
+		values[i] = value
+	}
+	return values, nil
+}
+
+//InputMedia This object represents the content of a media message to be sent.
+type InputMedia interface {
+	InputMediaType() string
+}
+
+//InputMediaType type of InputMediaAnimation
+func (InputMediaAnimation) InputMediaType() string {
+	return "animation"
+}
+
+//MarshalJSON fills type, so it may be left empty
+func (value InputMediaAnimation) MarshalJSON() ([]byte, error) {
+	type plain InputMediaAnimation
+	value.Type = "animation"
+	return json.Marshal(plain(value))
+}
+
+//InputMediaType type of InputMediaDocument
+func (InputMediaDocument) InputMediaType() string {
+	return "document"
+}
+
+//MarshalJSON fills type, so it may be left empty
+func (value InputMediaDocument) MarshalJSON() ([]byte, error) {
+	type plain InputMediaDocument
+	value.Type = "document"
+	return json.Marshal(plain(value))
+}
+
+//InputMediaType type of InputMediaAudio
+func (InputMediaAudio) InputMediaType() string {
+	return "audio"
+}
+
+//MarshalJSON fills type, so it may be left empty
+func (value InputMediaAudio) MarshalJSON() ([]byte, error) {
+	type plain InputMediaAudio
+	value.Type = "audio"
+	return json.Marshal(plain(value))
+}
+
+//InputMediaType type of InputMediaPhoto
+func (InputMediaPhoto) InputMediaType() string {
+	return "photo"
+}
+
+//MarshalJSON fills type, so it may be left empty
+func (value InputMediaPhoto) MarshalJSON() ([]byte, error) {
+	type plain InputMediaPhoto
+	value.Type = "photo"
+	return json.Marshal(plain(value))
+}
+
+//InputMediaType type of InputMediaVideo
+func (InputMediaVideo) InputMediaType() string {
+	return "video"
+}
+
+//MarshalJSON fills type, so it may be left empty
+func (value InputMediaVideo) MarshalJSON() ([]byte, error) {
+	type plain InputMediaVideo
+	value.Type = "video"
+	return json.Marshal(plain(value))
+}
+
+//UnmarshalInputMedia decodes the variant chosen by type. null is decoded to nil
+func UnmarshalInputMedia(data []byte) (InputMedia, error) {
+	if isEmptyJSON(data) {
+		return nil, nil
+	}
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return nil, err
+	}
+	var discriminator string
+	_ = json.Unmarshal(fields["type"], &discriminator)
+	switch {
+	case discriminator == "animation":
+		var value InputMediaAnimation
+		var err = json.Unmarshal(data, &value)
+		return value, err
+	case discriminator == "document":
+		var value InputMediaDocument
+		var err = json.Unmarshal(data, &value)
+		return value, err
+	case discriminator == "audio":
+		var value InputMediaAudio
+		var err = json.Unmarshal(data, &value)
+		return value, err
+	case discriminator == "photo":
+		var value InputMediaPhoto
+		var err = json.Unmarshal(data, &value)
+		return value, err
+	case discriminator == "video":
+		var value InputMediaVideo
+		var err = json.Unmarshal(data, &value)
+		return value, err
+	}
+	return nil, fmt.Errorf("unknown InputMedia: %s", data)
+}
+
+//UnmarshalInputMediaArray decodes array of InputMedia
+func UnmarshalInputMediaArray(data []byte) ([]InputMedia, error) {
+	if isEmptyJSON(data) {
+		return nil, nil
+	}
+	var items []json.RawMessage
+	if err := json.Unmarshal(data, &items); err != nil {
+		return nil, err
+	}
+	var values = make([]InputMedia, len(items))
+	for i, item := range items {
+		var value, err = UnmarshalInputMedia(item)
+		if err != nil {
+			return nil, err
+		}
+		values[i] = value
+	}
+	return values, nil
+}
+
+//InputMessageContent This object represents the content of a message to be sent as a result of an inline query.
+type InputMessageContent interface {
+	IsInputMessageContent()
+}
+
+//IsInputMessageContent InputTextMessageContent is InputMessageContent
+func (InputTextMessageContent) IsInputMessageContent() {}
+
+//IsInputMessageContent InputVenueMessageContent is InputMessageContent
+func (InputVenueMessageContent) IsInputMessageContent() {}
+
+//IsInputMessageContent InputLocationMessageContent is InputMessageContent
+func (InputLocationMessageContent) IsInputMessageContent() {}
+
+//IsInputMessageContent InputContactMessageContent is InputMessageContent
+func (InputContactMessageContent) IsInputMessageContent() {}
+
+//IsInputMessageContent InputInvoiceMessageContent is InputMessageContent
+func (InputInvoiceMessageContent) IsInputMessageContent() {}
+
+//UnmarshalInputMessageContent decodes the variant by the fields it has. null is decoded to nil
+func UnmarshalInputMessageContent(data []byte) (InputMessageContent, error) {
+	if isEmptyJSON(data) {
+		return nil, nil
+	}
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return nil, err
+	}
+	switch {
+	case hasField(fields, "message_text"):
+		var value InputTextMessageContent
+		var err = json.Unmarshal(data, &value)
+		return value, err
+	case hasField(fields, "address"):
+		var value InputVenueMessageContent
+		var err = json.Unmarshal(data, &value)
+		return value, err
+	case hasField(fields, "latitude"):
+		var value InputLocationMessageContent
+		var err = json.Unmarshal(data, &value)
+		return value, err
+	case hasField(fields, "phone_number"):
+		var value InputContactMessageContent
+		var err = json.Unmarshal(data, &value)
+		return value, err
+	case hasField(fields, "payload"):
+		var value InputInvoiceMessageContent
+		var err = json.Unmarshal(data, &value)
+		return value, err
+	}
+	return nil, fmt.Errorf("unknown InputMessageContent: %s", data)
+}
+
+//UnmarshalInputMessageContentArray decodes array of InputMessageContent
+func UnmarshalInputMessageContentArray(data []byte) ([]InputMessageContent, error) {
+	if isEmptyJSON(data) {
+		return nil, nil
+	}
+	var items []json.RawMessage
+	if err := json.Unmarshal(data, &items); err != nil {
+		return nil, err
+	}
+	var values = make([]InputMessageContent, len(items))
+	for i, item := range items {
+		var value, err = UnmarshalInputMessageContent(item)
+		if err != nil {
+			return nil, err
+		}
+		values[i] = value
+	}
+	return values, nil
+}
+
+//ChatMember This object contains information about one member of a chat.
+type ChatMember interface {
+	ChatMemberType() string
+}
+
+//ChatMemberType status of ChatMemberOwner
+func (ChatMemberOwner) ChatMemberType() string {
+	return "creator"
+}
+
+//MarshalJSON fills status, so it may be left empty
+func (value ChatMemberOwner) MarshalJSON() ([]byte, error) {
+	type plain ChatMemberOwner
+	value.Status = "creator"
+	return json.Marshal(plain(value))
+}
+
+//ChatMemberType status of ChatMemberAdministrator
+func (ChatMemberAdministrator) ChatMemberType() string {
+	return "administrator"
+}
+
+//MarshalJSON fills status, so it may be left empty
+func (value ChatMemberAdministrator) MarshalJSON() ([]byte, error) {
+	type plain ChatMemberAdministrator
+	value.Status = "administrator"
+	return json.Marshal(plain(value))
+}
+
+//ChatMemberType status of ChatMemberMember
+func (ChatMemberMember) ChatMemberType() string {
+	return "member"
+}
+
+//MarshalJSON fills status, so it may be left empty
+func (value ChatMemberMember) MarshalJSON() ([]byte, error) {
+	type plain ChatMemberMember
+	value.Status = "member"
+	return json.Marshal(plain(value))
+}
+
+//ChatMemberType status of ChatMemberRestricted
+func (ChatMemberRestricted) ChatMemberType() string {
+	return "restricted"
+}
+
+//MarshalJSON fills status, so it may be left empty
+func (value ChatMemberRestricted) MarshalJSON() ([]byte, error) {
+	type plain ChatMemberRestricted
+	value.Status = "restricted"
+	return json.Marshal(plain(value))
+}
+
+//ChatMemberType status of ChatMemberLeft
+func (ChatMemberLeft) ChatMemberType() string {
+	return "left"
+}
+
+//MarshalJSON fills status, so it may be left empty
+func (value ChatMemberLeft) MarshalJSON() ([]byte, error) {
+	type plain ChatMemberLeft
+	value.Status = "left"
+	return json.Marshal(plain(value))
+}
+
+//ChatMemberType status of ChatMemberBanned
+func (ChatMemberBanned) ChatMemberType() string {
+	return "kicked"
+}
+
+//MarshalJSON fills status, so it may be left empty
+func (value ChatMemberBanned) MarshalJSON() ([]byte, error) {
+	type plain ChatMemberBanned
+	value.Status = "kicked"
+	return json.Marshal(plain(value))
+}
+
+//UnmarshalChatMember decodes the variant chosen by status. null is decoded to nil
+func UnmarshalChatMember(data []byte) (ChatMember, error) {
+	if isEmptyJSON(data) {
+		return nil, nil
+	}
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return nil, err
+	}
+	var discriminator string
+	_ = json.Unmarshal(fields["status"], &discriminator)
+	switch {
+	case discriminator == "creator":
+		var value ChatMemberOwner
+		var err = json.Unmarshal(data, &value)
+		return value, err
+	case discriminator == "administrator":
+		var value ChatMemberAdministrator
+		var err = json.Unmarshal(data, &value)
+		return value, err
+	case discriminator == "member":
+		var value ChatMemberMember
+		var err = json.Unmarshal(data, &value)
+		return value, err
+	case discriminator == "restricted":
+		var value ChatMemberRestricted
+		var err = json.Unmarshal(data, &value)
+		return value, err
+	case discriminator == "left":
+		var value ChatMemberLeft
+		var err = json.Unmarshal(data, &value)
+		return value, err
+	case discriminator == "kicked":
+		var value ChatMemberBanned
+		var err = json.Unmarshal(data, &value)
+		return value, err
+	}
+	return nil, fmt.Errorf("unknown ChatMember: %s", data)
+}
+
+//UnmarshalChatMemberArray decodes array of ChatMember
+func UnmarshalChatMemberArray(data []byte) ([]ChatMember, error) {
+	if isEmptyJSON(data) {
+		return nil, nil
+	}
+	var items []json.RawMessage
+	if err := json.Unmarshal(data, &items); err != nil {
+		return nil, err
+	}
+	var values = make([]ChatMember, len(items))
+	for i, item := range items {
+		var value, err = UnmarshalChatMember(item)
+		if err != nil {
+			return nil, err
+		}
+		values[i] = value
+	}
+	return values, nil
+}
+
+//BotCommandScope This object represents the scope to which bot commands are applied.
+type BotCommandScope interface {
+	BotCommandScopeType() string
+}
+
+//BotCommandScopeType type of BotCommandScopeDefault
+func (BotCommandScopeDefault) BotCommandScopeType() string {
+	return "default"
+}
+
+//MarshalJSON fills type, so it may be left empty
+func (value BotCommandScopeDefault) MarshalJSON() ([]byte, error) {
+	type plain BotCommandScopeDefault
+	value.Type = "default"
+	return json.Marshal(plain(value))
+}
+
+//BotCommandScopeType type of BotCommandScopeAllPrivateChats
+func (BotCommandScopeAllPrivateChats) BotCommandScopeType() string {
+	return "all_private_chats"
+}
+
+//MarshalJSON fills type, so it may be left empty
+func (value BotCommandScopeAllPrivateChats) MarshalJSON() ([]byte, error) {
+	type plain BotCommandScopeAllPrivateChats
+	value.Type = "all_private_chats"
+	return json.Marshal(plain(value))
+}
+
+//BotCommandScopeType type of BotCommandScopeAllGroupChats
+func (BotCommandScopeAllGroupChats) BotCommandScopeType() string {
+	return "all_group_chats"
+}
+
+//MarshalJSON fills type, so it may be left empty
+func (value BotCommandScopeAllGroupChats) MarshalJSON() ([]byte, error) {
+	type plain BotCommandScopeAllGroupChats
+	value.Type = "all_group_chats"
+	return json.Marshal(plain(value))
+}
+
+//BotCommandScopeType type of BotCommandScopeAllChatAdministrators
+func (BotCommandScopeAllChatAdministrators) BotCommandScopeType() string {
+	return "all_chat_administrators"
+}
+
+//MarshalJSON fills type, so it may be left empty
+func (value BotCommandScopeAllChatAdministrators) MarshalJSON() ([]byte, error) {
+	type plain BotCommandScopeAllChatAdministrators
+	value.Type = "all_chat_administrators"
+	return json.Marshal(plain(value))
+}
+
+//BotCommandScopeType type of BotCommandScopeChat
+func (BotCommandScopeChat) BotCommandScopeType() string {
+	return "chat"
+}
+
+//MarshalJSON fills type, so it may be left empty
+func (value BotCommandScopeChat) MarshalJSON() ([]byte, error) {
+	type plain BotCommandScopeChat
+	value.Type = "chat"
+	return json.Marshal(plain(value))
+}
+
+//BotCommandScopeType type of BotCommandScopeChatAdministrators
+func (BotCommandScopeChatAdministrators) BotCommandScopeType() string {
+	return "chat_administrators"
+}
+
+//MarshalJSON fills type, so it may be left empty
+func (value BotCommandScopeChatAdministrators) MarshalJSON() ([]byte, error) {
+	type plain BotCommandScopeChatAdministrators
+	value.Type = "chat_administrators"
+	return json.Marshal(plain(value))
+}
+
+//BotCommandScopeType type of BotCommandScopeChatMember
+func (BotCommandScopeChatMember) BotCommandScopeType() string {
+	return "chat_member"
+}
+
+//MarshalJSON fills type, so it may be left empty
+func (value BotCommandScopeChatMember) MarshalJSON() ([]byte, error) {
+	type plain BotCommandScopeChatMember
+	value.Type = "chat_member"
+	return json.Marshal(plain(value))
+}
+
+//UnmarshalBotCommandScope decodes the variant chosen by type. null is decoded to nil
+func UnmarshalBotCommandScope(data []byte) (BotCommandScope, error) {
+	if isEmptyJSON(data) {
+		return nil, nil
+	}
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return nil, err
+	}
+	var discriminator string
+	_ = json.Unmarshal(fields["type"], &discriminator)
+	switch {
+	case discriminator == "default":
+		var value BotCommandScopeDefault
+		var err = json.Unmarshal(data, &value)
+		return value, err
+	case discriminator == "all_private_chats":
+		var value BotCommandScopeAllPrivateChats
+		var err = json.Unmarshal(data, &value)
+		return value, err
+	case discriminator == "all_group_chats":
+		var value BotCommandScopeAllGroupChats
+		var err = json.Unmarshal(data, &value)
+		return value, err
+	case discriminator == "all_chat_administrators":
+		var value BotCommandScopeAllChatAdministrators
+		var err = json.Unmarshal(data, &value)
+		return value, err
+	case discriminator == "chat":
+		var value BotCommandScopeChat
+		var err = json.Unmarshal(data, &value)
+		return value, err
+	case discriminator == "chat_administrators":
+		var value BotCommandScopeChatAdministrators
+		var err = json.Unmarshal(data, &value)
+		return value, err
+	case discriminator == "chat_member":
+		var value BotCommandScopeChatMember
+		var err = json.Unmarshal(data, &value)
+		return value, err
+	}
+	return nil, fmt.Errorf("unknown BotCommandScope: %s", data)
+}
+
+//UnmarshalBotCommandScopeArray decodes array of BotCommandScope
+func UnmarshalBotCommandScopeArray(data []byte) ([]BotCommandScope, error) {
+	if isEmptyJSON(data) {
+		return nil, nil
+	}
+	var items []json.RawMessage
+	if err := json.Unmarshal(data, &items); err != nil {
+		return nil, err
+	}
+	var values = make([]BotCommandScope, len(items))
+	for i, item := range items {
+		var value, err = UnmarshalBotCommandScope(item)
+		if err != nil {
+			return nil, err
+		}
+		values[i] = value
+	}
+	return values, nil
+}
+
+//PassportElementError This object represents an error in the Telegram Passport element which was submitted that should be resolved by the user.
+type PassportElementError interface {
+	PassportElementErrorType() string
+}
+
+//PassportElementErrorType source of PassportElementErrorDataField
+func (PassportElementErrorDataField) PassportElementErrorType() string {
+	return "data"
+}
+
+//MarshalJSON fills source, so it may be left empty
+func (value PassportElementErrorDataField) MarshalJSON() ([]byte, error) {
+	type plain PassportElementErrorDataField
+	value.Source = "data"
+	return json.Marshal(plain(value))
+}
+
+//PassportElementErrorType source of PassportElementErrorFrontSide
+func (PassportElementErrorFrontSide) PassportElementErrorType() string {
+	return "front_side"
+}
+
+//MarshalJSON fills source, so it may be left empty
+func (value PassportElementErrorFrontSide) MarshalJSON() ([]byte, error) {
+	type plain PassportElementErrorFrontSide
+	value.Source = "front_side"
+	return json.Marshal(plain(value))
+}
+
+//PassportElementErrorType source of PassportElementErrorReverseSide
+func (PassportElementErrorReverseSide) PassportElementErrorType() string {
+	return "reverse_side"
+}
+
+//MarshalJSON fills source, so it may be left empty
+func (value PassportElementErrorReverseSide) MarshalJSON() ([]byte, error) {
+	type plain PassportElementErrorReverseSide
+	value.Source = "reverse_side"
+	return json.Marshal(plain(value))
+}
+
+//PassportElementErrorType source of PassportElementErrorSelfie
+func (PassportElementErrorSelfie) PassportElementErrorType() string {
+	return "selfie"
+}
+
+//MarshalJSON fills source, so it may be left empty
+func (value PassportElementErrorSelfie) MarshalJSON() ([]byte, error) {
+	type plain PassportElementErrorSelfie
+	value.Source = "selfie"
+	return json.Marshal(plain(value))
+}
+
+//PassportElementErrorType source of PassportElementErrorFile
+func (PassportElementErrorFile) PassportElementErrorType() string {
+	return "file"
+}
+
+//MarshalJSON fills source, so it may be left empty
+func (value PassportElementErrorFile) MarshalJSON() ([]byte, error) {
+	type plain PassportElementErrorFile
+	value.Source = "file"
+	return json.Marshal(plain(value))
+}
+
+//PassportElementErrorType source of PassportElementErrorFiles
+func (PassportElementErrorFiles) PassportElementErrorType() string {
+	return "files"
+}
+
+//MarshalJSON fills source, so it may be left empty
+func (value PassportElementErrorFiles) MarshalJSON() ([]byte, error) {
+	type plain PassportElementErrorFiles
+	value.Source = "files"
+	return json.Marshal(plain(value))
+}
+
+//PassportElementErrorType source of PassportElementErrorTranslationFile
+func (PassportElementErrorTranslationFile) PassportElementErrorType() string {
+	return "translation_file"
+}
+
+//MarshalJSON fills source, so it may be left empty
+func (value PassportElementErrorTranslationFile) MarshalJSON() ([]byte, error) {
+	type plain PassportElementErrorTranslationFile
+	value.Source = "translation_file"
+	return json.Marshal(plain(value))
+}
+
+//PassportElementErrorType source of PassportElementErrorTranslationFiles
+func (PassportElementErrorTranslationFiles) PassportElementErrorType() string {
+	return "translation_files"
+}
+
+//MarshalJSON fills source, so it may be left empty
+func (value PassportElementErrorTranslationFiles) MarshalJSON() ([]byte, error) {
+	type plain PassportElementErrorTranslationFiles
+	value.Source = "translation_files"
+	return json.Marshal(plain(value))
+}
+
+//PassportElementErrorType source of PassportElementErrorUnspecified
+func (PassportElementErrorUnspecified) PassportElementErrorType() string {
+	return "unspecified"
+}
+
+//MarshalJSON fills source, so it may be left empty
+func (value PassportElementErrorUnspecified) MarshalJSON() ([]byte, error) {
+	type plain PassportElementErrorUnspecified
+	value.Source = "unspecified"
+	return json.Marshal(plain(value))
+}
+
+//UnmarshalPassportElementError decodes the variant chosen by source. null is decoded to nil
+func UnmarshalPassportElementError(data []byte) (PassportElementError, error) {
+	if isEmptyJSON(data) {
+		return nil, nil
+	}
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return nil, err
+	}
+	var discriminator string
+	_ = json.Unmarshal(fields["source"], &discriminator)
+	switch {
+	case discriminator == "data":
+		var value PassportElementErrorDataField
+		var err = json.Unmarshal(data, &value)
+		return value, err
+	case discriminator == "front_side":
+		var value PassportElementErrorFrontSide
+		var err = json.Unmarshal(data, &value)
+		return value, err
+	case discriminator == "reverse_side":
+		var value PassportElementErrorReverseSide
+		var err = json.Unmarshal(data, &value)
+		return value, err
+	case discriminator == "selfie":
+		var value PassportElementErrorSelfie
+		var err = json.Unmarshal(data, &value)
+		return value, err
+	case discriminator == "file":
+		var value PassportElementErrorFile
+		var err = json.Unmarshal(data, &value)
+		return value, err
+	case discriminator == "files":
+		var value PassportElementErrorFiles
+		var err = json.Unmarshal(data, &value)
+		return value, err
+	case discriminator == "translation_file":
+		var value PassportElementErrorTranslationFile
+		var err = json.Unmarshal(data, &value)
+		return value, err
+	case discriminator == "translation_files":
+		var value PassportElementErrorTranslationFiles
+		var err = json.Unmarshal(data, &value)
+		return value, err
+	case discriminator == "unspecified":
+		var value PassportElementErrorUnspecified
+		var err = json.Unmarshal(data, &value)
+		return value, err
+	}
+	return nil, fmt.Errorf("unknown PassportElementError: %s", data)
+}
+
+//UnmarshalPassportElementErrorArray decodes array of PassportElementError
+func UnmarshalPassportElementErrorArray(data []byte) ([]PassportElementError, error) {
+	if isEmptyJSON(data) {
+		return nil, nil
+	}
+	var items []json.RawMessage
+	if err := json.Unmarshal(data, &items); err != nil {
+		return nil, err
+	}
+	var values = make([]PassportElementError, len(items))
+	for i, item := range items {
+		var value, err = UnmarshalPassportElementError(item)
+		if err != nil {
+			return nil, err
+		}
+		values[i] = value
+	}
+	return values, nil
+}
+
+//ReplyMarkup Additional interface options: inline keyboard, custom reply keyboard, instructions to remove reply keyboard or to force a reply from the user.
+type ReplyMarkup interface {
+	IsReplyMarkup()
+}
+
+//IsReplyMarkup InlineKeyboardMarkup is ReplyMarkup
+func (InlineKeyboardMarkup) IsReplyMarkup() {}
+
+//IsReplyMarkup ReplyKeyboardMarkup is ReplyMarkup
+func (ReplyKeyboardMarkup) IsReplyMarkup() {}
+
+//IsReplyMarkup ReplyKeyboardRemove is ReplyMarkup
+func (ReplyKeyboardRemove) IsReplyMarkup() {}
+
+//IsReplyMarkup ForceReply is ReplyMarkup
+func (ForceReply) IsReplyMarkup() {}
+
+//UnmarshalReplyMarkup decodes the variant by the fields it has. null is decoded to nil
+func UnmarshalReplyMarkup(data []byte) (ReplyMarkup, error) {
+	if isEmptyJSON(data) {
+		return nil, nil
+	}
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return nil, err
+	}
+	switch {
+	case hasField(fields, "inline_keyboard"):
+		var value InlineKeyboardMarkup
+		var err = json.Unmarshal(data, &value)
+		return value, err
+	case hasField(fields, "keyboard"):
+		var value ReplyKeyboardMarkup
+		var err = json.Unmarshal(data, &value)
+		return value, err
+	case hasField(fields, "remove_keyboard"):
+		var value ReplyKeyboardRemove
+		var err = json.Unmarshal(data, &value)
+		return value, err
+	case hasField(fields, "force_reply"):
+		var value ForceReply
+		var err = json.Unmarshal(data, &value)
+		return value, err
+	}
+	return nil, fmt.Errorf("unknown ReplyMarkup: %s", data)
+}
+
+//UnmarshalReplyMarkupArray decodes array of ReplyMarkup
+func UnmarshalReplyMarkupArray(data []byte) ([]ReplyMarkup, error) {
+	if isEmptyJSON(data) {
+		return nil, nil
+	}
+	var items []json.RawMessage
+	if err := json.Unmarshal(data, &items); err != nil {
+		return nil, err
+	}
+	var values = make([]ReplyMarkup, len(items))
+	for i, item := range items {
+		var value, err = UnmarshalReplyMarkup(item)
+		if err != nil {
+			return nil, err
+		}
+		values[i] = value
+	}
+	return values, nil
+}
+
+//UnmarshalJSON decodes union fields of InlineQueryResultArticle
+func (value *InlineQueryResultArticle) UnmarshalJSON(data []byte) error {
+	type plain InlineQueryResultArticle
+	var decoded = struct {
+		*plain
+		InputMessageContent json.RawMessage `json:"input_message_content"`
+	}{plain: (*plain)(value)}
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		return err
+	}
+	var err error
+	if value.InputMessageContent, err = UnmarshalInputMessageContent(decoded.InputMessageContent); err != nil {
+		return err
+	}
+	return nil
+}
+
+//UnmarshalJSON decodes union fields of InlineQueryResultPhoto
+func (value *InlineQueryResultPhoto) UnmarshalJSON(data []byte) error {
+	type plain InlineQueryResultPhoto
+	var decoded = struct {
+		*plain
+		InputMessageContent json.RawMessage `json:"input_message_content"`
+	}{plain: (*plain)(value)}
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		return err
+	}
+	var err error
+	if value.InputMessageContent, err = UnmarshalInputMessageContent(decoded.InputMessageContent); err != nil {
+		return err
+	}
+	return nil
+}
+
+//UnmarshalJSON decodes union fields of InlineQueryResultGif
+func (value *InlineQueryResultGif) UnmarshalJSON(data []byte) error {
+	type plain InlineQueryResultGif
+	var decoded = struct {
+		*plain
+		InputMessageContent json.RawMessage `json:"input_message_content"`
+	}{plain: (*plain)(value)}
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		return err
+	}
+	var err error
+	if value.InputMessageContent, err = UnmarshalInputMessageContent(decoded.InputMessageContent); err != nil {
+		return err
+	}
+	return nil
+}
+
+//UnmarshalJSON decodes union fields of InlineQueryResultMpeg4Gif
+func (value *InlineQueryResultMpeg4Gif) UnmarshalJSON(data []byte) error {
+	type plain InlineQueryResultMpeg4Gif
+	var decoded = struct {
+		*plain
+		InputMessageContent json.RawMessage `json:"input_message_content"`
+	}{plain: (*plain)(value)}
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		return err
+	}
+	var err error
+	if value.InputMessageContent, err = UnmarshalInputMessageContent(decoded.InputMessageContent); err != nil {
+		return err
+	}
+	return nil
+}
+
+//UnmarshalJSON decodes union fields of InlineQueryResultVideo
+func (value *InlineQueryResultVideo) UnmarshalJSON(data []byte) error {
+	type plain InlineQueryResultVideo
+	var decoded = struct {
+		*plain
+		InputMessageContent json.RawMessage `json:"input_message_content"`
+	}{plain: (*plain)(value)}
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		return err
+	}
+	var err error
+	if value.InputMessageContent, err = UnmarshalInputMessageContent(decoded.InputMessageContent); err != nil {
+		return err
+	}
+	return nil
+}
+
+//UnmarshalJSON decodes union fields of InlineQueryResultAudio
+func (value *InlineQueryResultAudio) UnmarshalJSON(data []byte) error {
+	type plain InlineQueryResultAudio
+	var decoded = struct {
+		*plain
+		InputMessageContent json.RawMessage `json:"input_message_content"`
+	}{plain: (*plain)(value)}
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		return err
+	}
+	var err error
+	if value.InputMessageContent, err = UnmarshalInputMessageContent(decoded.InputMessageContent); err != nil {
+		return err
+	}
+	return nil
+}
+
+//UnmarshalJSON decodes union fields of InlineQueryResultVoice
+func (value *InlineQueryResultVoice) UnmarshalJSON(data []byte) error {
+	type plain InlineQueryResultVoice
+	var decoded = struct {
+		*plain
+		InputMessageContent json.RawMessage `json:"input_message_content"`
+	}{plain: (*plain)(value)}
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		return err
+	}
+	var err error
+	if value.InputMessageContent, err = UnmarshalInputMessageContent(decoded.InputMessageContent); err != nil {
+		return err
+	}
+	return nil
+}
+
+//UnmarshalJSON decodes union fields of InlineQueryResultDocument
+func (value *InlineQueryResultDocument) UnmarshalJSON(data []byte) error {
+	type plain InlineQueryResultDocument
+	var decoded = struct {
+		*plain
+		InputMessageContent json.RawMessage `json:"input_message_content"`
+	}{plain: (*plain)(value)}
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		return err
+	}
+	var err error
+	if value.InputMessageContent, err = UnmarshalInputMessageContent(decoded.InputMessageContent); err != nil {
+		return err
+	}
+	return nil
+}
+
+//UnmarshalJSON decodes union fields of InlineQueryResultLocation
+func (value *InlineQueryResultLocation) UnmarshalJSON(data []byte) error {
+	type plain InlineQueryResultLocation
+	var decoded = struct {
+		*plain
+		InputMessageContent json.RawMessage `json:"input_message_content"`
+	}{plain: (*plain)(value)}
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		return err
+	}
+	var err error
+	if value.InputMessageContent, err = UnmarshalInputMessageContent(decoded.InputMessageContent); err != nil {
+		return err
+	}
+	return nil
+}
+
+//UnmarshalJSON decodes union fields of InlineQueryResultVenue
+func (value *InlineQueryResultVenue) UnmarshalJSON(data []byte) error {
+	type plain InlineQueryResultVenue
+	var decoded = struct {
+		*plain
+		InputMessageContent json.RawMessage `json:"input_message_content"`
+	}{plain: (*plain)(value)}
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		return err
+	}
+	var err error
+	if value.InputMessageContent, err = UnmarshalInputMessageContent(decoded.InputMessageContent); err != nil {
+		return err
+	}
+	return nil
+}
+
+//UnmarshalJSON decodes union fields of InlineQueryResultContact
+func (value *InlineQueryResultContact) UnmarshalJSON(data []byte) error {
+	type plain InlineQueryResultContact
+	var decoded = struct {
+		*plain
+		InputMessageContent json.RawMessage `json:"input_message_content"`
+	}{plain: (*plain)(value)}
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		return err
+	}
+	var err error
+	if value.InputMessageContent, err = UnmarshalInputMessageContent(decoded.InputMessageContent); err != nil {
+		return err
+	}
+	return nil
+}
+
+//UnmarshalJSON decodes union fields of InlineQueryResultCachedPhoto
+func (value *InlineQueryResultCachedPhoto) UnmarshalJSON(data []byte) error {
+	type plain InlineQueryResultCachedPhoto
+	var decoded = struct {
+		*plain
+		InputMessageContent json.RawMessage `json:"input_message_content"`
+	}{plain: (*plain)(value)}
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		return err
+	}
+	var err error
+	if value.InputMessageContent, err = UnmarshalInputMessageContent(decoded.InputMessageContent); err != nil {
+		return err
+	}
+	return nil
+}
+
+//UnmarshalJSON decodes union fields of InlineQueryResultCachedGif
+func (value *InlineQueryResultCachedGif) UnmarshalJSON(data []byte) error {
+	type plain InlineQueryResultCachedGif
+	var decoded = struct {
+		*plain
+		InputMessageContent json.RawMessage `json:"input_message_content"`
+	}{plain: (*plain)(value)}
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		return err
+	}
+	var err error
+	if value.InputMessageContent, err = UnmarshalInputMessageContent(decoded.InputMessageContent); err != nil {
+		return err
+	}
+	return nil
+}
+
+//UnmarshalJSON decodes union fields of InlineQueryResultCachedMpeg4Gif
+func (value *InlineQueryResultCachedMpeg4Gif) UnmarshalJSON(data []byte) error {
+	type plain InlineQueryResultCachedMpeg4Gif
+	var decoded = struct {
+		*plain
+		InputMessageContent json.RawMessage `json:"input_message_content"`
+	}{plain: (*plain)(value)}
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		return err
+	}
+	var err error
+	if value.InputMessageContent, err = UnmarshalInputMessageContent(decoded.InputMessageContent); err != nil {
+		return err
+	}
+	return nil
+}
+
+//UnmarshalJSON decodes union fields of InlineQueryResultCachedSticker
+func (value *InlineQueryResultCachedSticker) UnmarshalJSON(data []byte) error {
+	type plain InlineQueryResultCachedSticker
+	var decoded = struct {
+		*plain
+		InputMessageContent json.RawMessage `json:"input_message_content"`
+	}{plain: (*plain)(value)}
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		return err
+	}
+	var err error
+	if value.InputMessageContent, err = UnmarshalInputMessageContent(decoded.InputMessageContent); err != nil {
+		return err
+	}
+	return nil
+}
+
+//UnmarshalJSON decodes union fields of InlineQueryResultCachedDocument
+func (value *InlineQueryResultCachedDocument) UnmarshalJSON(data []byte) error {
+	type plain InlineQueryResultCachedDocument
+	var decoded = struct {
+		*plain
+		InputMessageContent json.RawMessage `json:"input_message_content"`
+	}{plain: (*plain)(value)}
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		return err
+	}
+	var err error
+	if value.InputMessageContent, err = UnmarshalInputMessageContent(decoded.InputMessageContent); err != nil {
+		return err
+	}
+	return nil
+}
+
+//UnmarshalJSON decodes union fields of InlineQueryResultCachedVideo
+func (value *InlineQueryResultCachedVideo) UnmarshalJSON(data []byte) error {
+	type plain InlineQueryResultCachedVideo
+	var decoded = struct {
+		*plain
+		InputMessageContent json.RawMessage `json:"input_message_content"`
+	}{plain: (*plain)(value)}
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		return err
+	}
+	var err error
+	if value.InputMessageContent, err = UnmarshalInputMessageContent(decoded.InputMessageContent); err != nil {
+		return err
+	}
+	return nil
+}
+
+//UnmarshalJSON decodes union fields of InlineQueryResultCachedVoice
+func (value *InlineQueryResultCachedVoice) UnmarshalJSON(data []byte) error {
+	type plain InlineQueryResultCachedVoice
+	var decoded = struct {
+		*plain
+		InputMessageContent json.RawMessage `json:"input_message_content"`
+	}{plain: (*plain)(value)}
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		return err
+	}
+	var err error
+	if value.InputMessageContent, err = UnmarshalInputMessageContent(decoded.InputMessageContent); err != nil {
+		return err
+	}
+	return nil
+}
+
+//UnmarshalJSON decodes union fields of InlineQueryResultCachedAudio
+func (value *InlineQueryResultCachedAudio) UnmarshalJSON(data []byte) error {
+	type plain InlineQueryResultCachedAudio
+	var decoded = struct {
+		*plain
+		InputMessageContent json.RawMessage `json:"input_message_content"`
+	}{plain: (*plain)(value)}
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		return err
+	}
+	var err error
+	if value.InputMessageContent, err = UnmarshalInputMessageContent(decoded.InputMessageContent); err != nil {
+		return err
+	}
+	return nil
+}
+
+//UnmarshalJSON decodes union fields of ChatMemberUpdated
+func (value *ChatMemberUpdated) UnmarshalJSON(data []byte) error {
+	type plain ChatMemberUpdated
+	var decoded = struct {
+		*plain
+		OldChatMember json.RawMessage `json:"old_chat_member"`
+		NewChatMember json.RawMessage `json:"new_chat_member"`
+	}{plain: (*plain)(value)}
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		return err
+	}
+	var err error
+	if value.OldChatMember, err = UnmarshalChatMember(decoded.OldChatMember); err != nil {
+		return err
+	}
+	if value.NewChatMember, err = UnmarshalChatMember(decoded.NewChatMember); err != nil {
+		return err
+	}
+	return nil
+}
+
+func isEmptyJSON(data []byte) bool {
+	return len(data) == 0 || string(data) == "null"
+}
+
+func hasField(fields map[string]json.RawMessage, name string) bool {
+	var _, ok = fields[name]
+	return ok
+}
@@ -0,0 +1,203 @@
+package pager
+
+import (
+	"bitbucket.org/y4cxp543/telegram-bot/constants"
+	"bitbucket.org/y4cxp543/telegram-bot/interfaces"
+	"bitbucket.org/y4cxp543/telegram-bot/telegram/callback"
+	"bitbucket.org/y4cxp543/telegram-bot/telegram/models"
+	"context"
+	"errors"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+//Prefix callback_data prefix of pager buttons: "pg:p:<page>" turns the page, "pg:k:<index>" picks the item
+const Prefix = "pg"
+
+const (
+	pageAction = "p"
+	pickAction = "k"
+)
+
+const (
+	DefaultPageSize = 5
+	DefaultTTL      = time.Hour
+	//maxButtonText longer labels are cut by clients anyway
+	maxButtonText = 48
+	//ownerOnlyText answer to the buttons of the list shown to another user
+	ownerOnlyText = "Only the user who asked for the list can use its buttons"
+)
+
+//list state of one message with the list
+type list struct {
+	title   string
+	items   []string
+	page    int
+	onPick  interfaces.IPickFunc
+	expires time.Time
+	//userId the only user who may press the buttons, 0 for everyone
+	userId int64
+}
+
+func (l *list) pageCount(pageSize int) int {
+	return (len(l.items) + pageSize - 1) / pageSize
+}
+
+//Pager keeps lists by chat and message id, buttons of expired or forgotten lists answer with an alert
+type Pager struct {
+	mutex      sync.Mutex
+	lists      map[string]*list
+	tFunctions interfaces.ITelegramFunctions
+	clock      interfaces.Clock
+	ttl        time.Duration
+	pageSize   int
+}
+
+func NewPager(tFunctions interfaces.ITelegramFunctions, clock interfaces.Clock, ttl time.Duration, pageSize int) *Pager {
+	return &Pager{
+		lists:      make(map[string]*list),
+		tFunctions: tFunctions,
+		clock:      clock,
+		ttl:        ttl,
+		pageSize:   pageSize,
+	}
+}
+
+func listKey(chatId int64, messageId int) string {
+	return strconv.FormatInt(chatId, 10) + constants.Space + strconv.Itoa(messageId)
+}
+
+func (pager *Pager) Show(ctx context.Context, chatId, userId int64, replyToMessageId int, title string, items []string, onPick interfaces.IPickFunc) error {
+	if len(items) == 0 {
+		return errors.New("list is empty")
+	}
+	var state = &list{title: title, userId: userId, items: items, onPick: onPick}
+	var text, markup = pager.render(state)
+	var message, err = pager.tFunctions.SendMessage(ctx, models.SendMessage{
+		ChatId:           models.NewChatID(chatId),
+		Text:             text,
+		ReplyToMessageId: models.Int(replyToMessageId),
+		ReplyMarkup:      markup,
+	})
+	if err != nil {
+		return err
+	}
+	pager.mutex.Lock()
+	defer pager.mutex.Unlock()
+	pager.removeExpired()
+	state.expires = pager.clock.Now().Add(pager.ttl)
+	pager.lists[listKey(chatId, message.MessageId)] = state
+	return nil
+}
+
+//HandleCallback handles buttons of the pager, register it for Prefix
+func (pager *Pager) HandleCallback(arg interfaces.CallbackArgument) (string, error) {
+	if len(arg.Args) != 2 {
+		return "", callback.ErrExpired
+	}
+	var number, err = strconv.Atoi(arg.Args[1])
+	if err != nil {
+		return "", callback.ErrExpired
+	}
+	var key = listKey(arg.ChatId, arg.MessageId)
+	pager.mutex.Lock()
+	var state, ok = pager.lists[key]
+	if ok && pager.clock.Now().After(state.expires) {
+		delete(pager.lists, key)
+		ok = false
+	}
+	pager.mutex.Unlock()
+	if !ok {
+		return "", callback.ErrExpired
+	}
+	//In groups everyone sees the buttons, the list and what is picked from it belong to the user who asked
+	if state.userId != 0 && (arg.Query == nil || arg.Query.From == nil || arg.Query.From.Id != state.userId) {
+		return ownerOnlyText, nil
+	}
+	switch arg.Args[0] {
+	case pageAction:
+		return "", pager.turn(arg, key, state, number)
+	case pickAction:
+		if number < 0 || number >= len(state.items) {
+			return "", callback.ErrExpired
+		}
+		return state.onPick(arg, number)
+	}
+	return "", callback.ErrExpired
+}
+
+//turn shows the page and prolongs the list
+func (pager *Pager) turn(arg interfaces.CallbackArgument, key string, state *list, page int) error {
+	pager.mutex.Lock()
+	if page < 0 || page >= state.pageCount(pager.pageSize) || page == state.page {
+		pager.mutex.Unlock()
+		return nil
+	}
+	state.page = page
+	state.expires = pager.clock.Now().Add(pager.ttl)
+	var text, markup = pager.render(state)
+	pager.mutex.Unlock()
+	var chatId = models.NewChatID(arg.ChatId)
+	_, err := pager.tFunctions.EditMessageText(arg.Context, models.EditMessageText{
+		ChatId:      &chatId,
+		MessageId:   models.Int(arg.MessageId),
+		Text:        text,
+		ReplyMarkup: markup,
+	})
+	return err
+}
+
+func (pager *Pager) removeExpired() {
+	var now = pager.clock.Now()
+	for key, state := range pager.lists {
+		if now.After(state.expires) {
+			delete(pager.lists, key)
+		}
+	}
+}
+
+//render text lists items of the current page in full, buttons pick them and turn pages
+func (pager *Pager) render(state *list) (string, *models.InlineKeyboardMarkup) {
+	var pageCount = state.pageCount(pager.pageSize)
+	var start = state.page * pager.pageSize
+	var end = start + pager.pageSize
+	if end > len(state.items) {
+		end = len(state.items)
+	}
+	var text = new(strings.Builder)
+	text.WriteString(state.title)
+	if pageCount > 1 {
+		text.WriteString("\nPage " + strconv.Itoa(state.page+1) + " of " + strconv.Itoa(pageCount))
+	}
+	var keyboard [][]models.InlineKeyboardButton
+	for index := start; index < end; index++ {
+		var number = strconv.Itoa(index + 1)
+		text.WriteString("\n\n" + number + ". " + state.items[index])
+		keyboard = append(keyboard, []models.InlineKeyboardButton{
+			button(number+". "+state.items[index], pickAction, index),
+		})
+	}
+	if pageCount > 1 {
+		var navigation []models.InlineKeyboardButton
+		if state.page > 0 {
+			navigation = append(navigation, button("« Prev", pageAction, state.page-1))
+		}
+		navigation = append(navigation, button(strconv.Itoa(state.page+1)+"/"+strconv.Itoa(pageCount), pageAction, state.page))
+		if state.page < pageCount-1 {
+			navigation = append(navigation, button("Next »", pageAction, state.page+1))
+		}
+		keyboard = append(keyboard, navigation)
+	}
+	return text.String(), &models.InlineKeyboardMarkup{InlineKeyboard: keyboard}
+}
+
+func button(text, action string, number int) models.InlineKeyboardButton {
+	if len([]rune(text)) > maxButtonText {
+		text = string([]rune(text)[:maxButtonText-len([]rune(constants.TreeDots))]) + constants.TreeDots
+	}
+	//Prefix, action and a number always fit into callback_data
+	var data, _ = callback.Encode(Prefix, action, strconv.Itoa(number))
+	return models.InlineKeyboardButton{Text: text, CallbackData: data}
+}
@@ -0,0 +1,103 @@
+package pager
+
+import (
+	"bitbucket.org/y4cxp543/telegram-bot/interfaces"
+	"bitbucket.org/y4cxp543/telegram-bot/telegram/callback"
+	"bitbucket.org/y4cxp543/telegram-bot/telegram/models"
+	"context"
+	"testing"
+	"time"
+)
+
+const (
+	groupId   int64 = -1001
+	searcher  int64 = 7
+	stranger  int64 = 8
+	messageId       = 10
+)
+
+type fakeClock struct {
+	now time.Time
+}
+
+func (clock *fakeClock) Now() time.Time {
+	return clock.now
+}
+
+func (clock *fakeClock) After(d time.Duration) <-chan time.Time {
+	return make(chan time.Time)
+}
+
+//fakeFunctions sends every list as message messageId and counts edits of it
+type fakeFunctions struct {
+	interfaces.ITelegramFunctions
+	edits int
+}
+
+func (functions *fakeFunctions) SendMessage(ctx context.Context, request models.SendMessage) (models.Message, error) {
+	return models.Message{MessageId: messageId}, nil
+}
+
+func (functions *fakeFunctions) EditMessageText(ctx context.Context, request models.EditMessageText) (models.MessageOrTrue, error) {
+	functions.edits++
+	return models.MessageOrTrue{}, nil
+}
+
+//press the button with callback data in the group as the user
+func press(pager *Pager, userId int64, data string) (string, error) {
+	var prefix, args = callback.Decode(data)
+	return pager.HandleCallback(interfaces.CallbackArgument{
+		Context:   context.Background(),
+		Prefix:    prefix,
+		Args:      args,
+		ChatId:    groupId,
+		MessageId: messageId,
+		Query:     &models.CallbackQuery{Id: "q", From: &models.User{Id: userId}},
+	})
+}
+
+func TestOnlyOwnerPressesButtons(t *testing.T) {
+	var functions = &fakeFunctions{}
+	var pager = NewPager(functions, &fakeClock{now: time.Now()}, DefaultTTL, 2)
+	var picked []int
+	var onPick = func(arg interfaces.CallbackArgument, index int) (string, error) {
+		picked = append(picked, index)
+		return "Picked", nil
+	}
+	var items = []string{"first", "second", "third"}
+	if err := pager.Show(context.Background(), groupId, searcher, 1, "Results", items, onPick); err != nil {
+		t.Fatal(err)
+	}
+	var next, _ = callback.Encode(Prefix, pageAction, "1")
+	var third, _ = callback.Encode(Prefix, pickAction, "2")
+
+	for _, data := range []string{next, third} {
+		if text, err := press(pager, stranger, data); err != nil || text != ownerOnlyText {
+			t.Errorf("Another user pressed %s: %q, %v", data, text, err)
+		}
+	}
+	if functions.edits != 0 || len(picked) != 0 {
+		t.Fatalf("Another user turned %d pages and picked %v", functions.edits, picked)
+	}
+
+	if _, err := press(pager, searcher, next); err != nil || functions.edits != 1 {
+		t.Errorf("Page is not turned by the searcher: %v, %d edits", err, functions.edits)
+	}
+	if text, err := press(pager, searcher, third); err != nil || text != "Picked" || len(picked) != 1 || picked[0] != 2 {
+		t.Errorf("Item is not picked by the searcher: %q, %v, %v", text, err, picked)
+	}
+}
+
+func TestEveryonePressesButtonsOfSharedList(t *testing.T) {
+	var pager = NewPager(&fakeFunctions{}, &fakeClock{now: time.Now()}, DefaultTTL, DefaultPageSize)
+	var onPick = func(arg interfaces.CallbackArgument, index int) (string, error) {
+		return "Picked", nil
+	}
+	if err := pager.Show(context.Background(), groupId, 0, 1, "Results", []string{"first"}, onPick); err != nil {
+		t.Fatal(err)
+	}
+	var first, _ = callback.Encode(Prefix, pickAction, "0")
+	if text, err := press(pager, stranger, first); err != nil || text != "Picked" {
+		t.Errorf("Shared list is not picked: %q, %v", text, err)
+	}
+}
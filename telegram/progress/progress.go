@@ -0,0 +1,410 @@
+package progress
+
+import (
+	"bitbucket.org/y4cxp543/aria2c"
+	"bitbucket.org/y4cxp543/telegram-bot/constants"
+	"bitbucket.org/y4cxp543/telegram-bot/interfaces"
+	"bitbucket.org/y4cxp543/telegram-bot/telegram/callback"
+	"bitbucket.org/y4cxp543/telegram-bot/telegram/models"
+	"bitbucket.org/y4cxp543/telegram-bot/util"
+	"context"
+	"errors"
+	"fmt"
+	"github.com/asaskevich/EventBus"
+	"log"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+//Prefix callback_data prefix of status message buttons: "dl:p:<gid>" pauses, "dl:r:<gid>" resumes, "dl:c:<gid>" cancels the download
+const Prefix = "dl"
+
+const (
+	pauseAction  = "p"
+	resumeAction = "r"
+	cancelAction = "c"
+)
+
+//DefaultInterval between status requests of a download. Telegram allows about 20 edits per minute in a group
+const DefaultInterval = 5 * time.Second
+
+const (
+	barWidth   = 10
+	timeLayout = "2006-01-02 15:04:05"
+)
+
+//Download states reported by aria2.tellStatus
+const (
+	statusActive   = "active"
+	statusWaiting  = "waiting"
+	statusPaused   = "paused"
+	statusError    = "error"
+	statusComplete = "complete"
+	statusRemoved  = "removed"
+)
+
+//status fields of aria2.tellStatus answer, aria2 sends numbers as strings
+type status struct {
+	state                   string
+	name                    string
+	total, completed, speed int64
+	connections, seeders    int
+	errorCode, errorMessage string
+	followedBy              string
+}
+
+func (st status) finished() bool {
+	return st.state == statusComplete || st.state == statusError || st.state == statusRemoved
+}
+
+//download one tracked download and its status message
+type download struct {
+	ctx       context.Context
+	gid       string
+	chatId    int64
+	messageId int
+	name      string
+	started   time.Time
+	text      string
+	finished  bool
+	//addedGid gid aria2 answered when the download was added, it is shown after gid is followed by another one
+	addedGid string
+	//force the next edit is not throttled, the user pressed a button and waits for it
+	force      bool
+	onComplete func(gid string)
+}
+
+//Tracker polls aria2.tellStatus of tracked downloads and edits their status messages.
+//Edits of one chat are throttled, unchanged text is not sent again
+type Tracker struct {
+	mutex     sync.Mutex
+	downloads map[string]*download
+	//requests tellStatus requests waiting for answer by request id
+	requests map[string]*download
+	//nextEdit time the chat may get the next edit
+	nextEdit   map[int64]time.Time
+	ariaApi    interfaces.IAriaApi
+	tFunctions interfaces.ITelegramFunctions
+	clock      interfaces.Clock
+	interval   time.Duration
+}
+
+//NewTracker zero interval uses DefaultInterval
+func NewTracker(ariaApi interfaces.IAriaApi, eventBus EventBus.Bus, tFunctions interfaces.ITelegramFunctions, clock interfaces.Clock, interval time.Duration) *Tracker {
+	if interval <= 0 {
+		interval = DefaultInterval
+	}
+	var tracker = &Tracker{
+		downloads:  make(map[string]*download),
+		requests:   make(map[string]*download),
+		nextEdit:   make(map[int64]time.Time),
+		ariaApi:    ariaApi,
+		tFunctions: tFunctions,
+		clock:      clock,
+		interval:   interval,
+	}
+	_ = eventBus.SubscribeAsync(aria2c.TellStatus, tracker.StatusReceived, false)
+	return tracker
+}
+
+func (tracker *Tracker) Track(ctx context.Context, chatId int64, replyToMessageId int, gid, name string, onComplete func(gid string)) error {
+	var tracked = &download{
+		ctx:        ctx,
+		gid:        gid,
+		addedGid:   gid,
+		chatId:     chatId,
+		name:       name,
+		started:    tracker.clock.Now(),
+		onComplete: onComplete,
+	}
+	var text, markup = render(tracked, status{state: statusWaiting}, tracker.clock.Now())
+	var message, err = tracker.tFunctions.SendMessage(ctx, models.SendMessage{
+		ChatId:           models.NewChatID(chatId),
+		Text:             text,
+		ReplyToMessageId: models.Int(replyToMessageId),
+		ReplyMarkup:      markup,
+	})
+	if err != nil {
+		return err
+	}
+	tracker.mutex.Lock()
+	tracked.messageId = message.MessageId
+	tracked.text = text
+	tracker.downloads[gid] = tracked
+	tracker.mutex.Unlock()
+	go tracker.poll(tracked)
+	return nil
+}
+
+func (tracker *Tracker) poll(tracked *download) {
+	for {
+		tracker.mutex.Lock()
+		if tracked.finished {
+			tracker.mutex.Unlock()
+			return
+		}
+		tracker.request(tracked)
+		tracker.mutex.Unlock()
+		select {
+		case <-tracked.ctx.Done():
+			tracker.mutex.Lock()
+			tracker.forget(tracked)
+			tracker.mutex.Unlock()
+			return
+		case <-tracker.clock.After(tracker.interval):
+		}
+	}
+}
+
+//request sends tellStatus, must be called under the mutex, so the answer is not handled before its id is kept
+func (tracker *Tracker) request(tracked *download) {
+	tracker.requests[tracker.ariaApi.TellStatusOf(tracked.gid)] = tracked
+}
+
+//forget stops tracking, must be called under the mutex
+func (tracker *Tracker) forget(tracked *download) {
+	tracked.finished = true
+	if tracker.downloads[tracked.gid] == tracked {
+		delete(tracker.downloads, tracked.gid)
+	}
+	for id, waiting := range tracker.requests {
+		if waiting == tracked {
+			delete(tracker.requests, id)
+		}
+	}
+}
+
+//StatusReceived handles aria2 answer for tellStatus and edits the status message if it is time to
+func (tracker *Tracker) StatusReceived(req *aria2c.Request, resp *aria2c.Response) {
+	tracker.mutex.Lock()
+	var tracked, ok = tracker.requests[req.Id]
+	delete(tracker.requests, req.Id)
+	if !ok || tracked.finished {
+		tracker.mutex.Unlock()
+		return
+	}
+	var now = tracker.clock.Now()
+	var st status
+	if resp.Error != nil {
+		//The download is removed from aria2 memory, nothing more will be known about it
+		st = status{state: statusError, errorMessage: util.GetAriaError(resp)}
+	} else {
+		st = parseStatus(resp.Result)
+	}
+	if st.state == statusComplete && st.followedBy != constants.EmptyString {
+		//Metadata of magnet link is downloaded, the files are downloaded by the new download
+		delete(tracker.downloads, tracked.gid)
+		tracked.gid = st.followedBy
+		tracker.downloads[tracked.gid] = tracked
+		st = status{state: statusWaiting, name: st.name}
+	}
+	if st.name != constants.EmptyString {
+		tracked.name = st.name
+	}
+	var final = st.finished()
+	if final {
+		tracker.forget(tracked)
+	}
+	var text, markup = render(tracked, st, now)
+	var gid = tracked.gid
+	var edit = text != tracked.text && (final || tracked.force || !now.Before(tracker.nextEdit[tracked.chatId]))
+	if edit {
+		tracked.text = text
+		tracked.force = false
+		tracker.nextEdit[tracked.chatId] = now.Add(tracker.interval)
+		for chat, next := range tracker.nextEdit {
+			if now.After(next) {
+				delete(tracker.nextEdit, chat)
+			}
+		}
+	}
+	tracker.mutex.Unlock()
+	if edit {
+		tracker.edit(tracked, gid, text, markup, now)
+	}
+	if final && st.state == statusComplete && tracked.onComplete != nil {
+		tracked.onComplete(gid)
+	}
+}
+
+//edit shows the status, flood control of Telegram postpones the following edits of the chat
+func (tracker *Tracker) edit(tracked *download, gid, text string, markup *models.InlineKeyboardMarkup, now time.Time) {
+	var chat = models.NewChatID(tracked.chatId)
+	_, err := tracker.tFunctions.EditMessageText(tracked.ctx, models.EditMessageText{
+		ChatId:      &chat,
+		MessageId:   models.Int(tracked.messageId),
+		Text:        text,
+		ReplyMarkup: markup,
+	})
+	if err == nil {
+		return
+	}
+	log.Println("Cannot edit status of download "+gid+": ", err)
+	var apiError *models.TelegramAPIError
+	if errors.As(err, &apiError) && apiError.RetryAfter > 0 {
+		tracker.mutex.Lock()
+		tracker.nextEdit[tracked.chatId] = now.Add(time.Duration(apiError.RetryAfter) * time.Second)
+		//Shown again with the next status
+		tracked.text = constants.EmptyString
+		tracker.mutex.Unlock()
+	}
+}
+
+//HandleCallback handles buttons of status messages, register it for Prefix
+func (tracker *Tracker) HandleCallback(arg interfaces.CallbackArgument) (string, error) {
+	if len(arg.Args) != 2 {
+		return constants.EmptyString, callback.ErrExpired
+	}
+	var gid = arg.Args[1]
+	tracker.mutex.Lock()
+	defer tracker.mutex.Unlock()
+	var tracked, ok = tracker.downloads[gid]
+	//Only the buttons of its own status message control the download
+	if !ok || tracked.chatId != arg.ChatId || tracked.messageId != arg.MessageId {
+		return constants.EmptyString, callback.ErrExpired
+	}
+	var text string
+	switch arg.Args[0] {
+	case pauseAction:
+		tracker.ariaApi.Pause(gid)
+		text = "Pausing"
+	case resumeAction:
+		tracker.ariaApi.UnpauseOf(gid)
+		text = "Resuming"
+	case cancelAction:
+		tracker.ariaApi.Remove(gid)
+		text = "Cancelling"
+	default:
+		return constants.EmptyString, callback.ErrExpired
+	}
+	tracked.force = true
+	tracker.request(tracked)
+	return text, nil
+}
+
+//parseStatus reads fields of tellStatus result
+func parseStatus(result interface{}) status {
+	var fields, _ = result.(map[string]interface{})
+	var text = func(key string) string {
+		var value, _ = fields[key].(string)
+		return value
+	}
+	var number = func(key string) int64 {
+		var value, _ = strconv.ParseInt(text(key), 10, 64)
+		return value
+	}
+	var st = status{
+		state:        text("status"),
+		total:        number("totalLength"),
+		completed:    number("completedLength"),
+		speed:        number("downloadSpeed"),
+		connections:  int(number("connections")),
+		seeders:      int(number("numSeeders")),
+		errorCode:    text("errorCode"),
+		errorMessage: text("errorMessage"),
+	}
+	if followedBy, ok := fields["followedBy"].([]interface{}); ok && len(followedBy) > 0 {
+		st.followedBy, _ = followedBy[0].(string)
+	}
+	if bittorrent, ok := fields["bittorrent"].(map[string]interface{}); ok {
+		if info, ok := bittorrent["info"].(map[string]interface{}); ok {
+			st.name, _ = info["name"].(string)
+		}
+	}
+	if files, ok := fields["files"].([]interface{}); ok && len(files) > 0 && st.name == constants.EmptyString {
+		if file, ok := files[0].(map[string]interface{}); ok {
+			if path, _ := file["path"].(string); path != constants.EmptyString {
+				st.name = filepath.Base(path)
+			}
+		}
+	}
+	return st
+}
+
+//render status message text and its buttons, finished downloads have no buttons
+func render(tracked *download, st status, now time.Time) (string, *models.InlineKeyboardMarkup) {
+	var text = new(strings.Builder)
+	if tracked.name != constants.EmptyString {
+		text.WriteString(tracked.name + "\n")
+	}
+	text.WriteString("Gid: " + tracked.addedGid)
+	if tracked.gid != tracked.addedGid {
+		text.WriteString(", followed by " + tracked.gid)
+	}
+	text.WriteString("\n")
+	switch st.state {
+	case statusComplete:
+		text.WriteString("Completed at " + now.Format(timeLayout) + " in " + now.Sub(tracked.started).Round(time.Second).String())
+		if st.total > 0 {
+			text.WriteString("\nSize: " + formatBytes(st.total))
+		}
+		return text.String(), nil
+	case statusError:
+		text.WriteString("Failed: " + st.errorMessage)
+		if st.errorCode != constants.EmptyString {
+			text.WriteString(" (code " + st.errorCode + ")")
+		}
+		return text.String(), nil
+	case statusRemoved:
+		text.WriteString("Cancelled")
+		return text.String(), nil
+	case statusWaiting:
+		text.WriteString("Waiting in the queue")
+		return text.String(), buttons(tracked.gid, pauseAction)
+	}
+	text.WriteString(bar(st.completed, st.total) + "\n")
+	text.WriteString(formatBytes(st.completed) + " of " + formatBytes(st.total) + "\n")
+	if st.state == statusPaused {
+		text.WriteString("Paused")
+		return text.String(), buttons(tracked.gid, resumeAction)
+	}
+	text.WriteString("Speed: " + formatBytes(st.speed) + "/s, ETA: " + eta(st) + "\n")
+	text.WriteString(fmt.Sprintf("Peers: %d, seeders: %d", st.connections, st.seeders))
+	return text.String(), buttons(tracked.gid, pauseAction)
+}
+
+//buttons pause or resume, depending on the state, and cancel. gid of aria2 is 16 hex digits, so data always fits
+func buttons(gid, toggleAction string) *models.InlineKeyboardMarkup {
+	var toggleText = "Pause"
+	if toggleAction == resumeAction {
+		toggleText = "Resume"
+	}
+	var toggle, _ = callback.Encode(Prefix, toggleAction, gid)
+	var cancel, _ = callback.Encode(Prefix, cancelAction, gid)
+	return &models.InlineKeyboardMarkup{InlineKeyboard: [][]models.InlineKeyboardButton{{
+		{Text: toggleText, CallbackData: toggle},
+		{Text: "Cancel", CallbackData: cancel},
+	}}}
+}
+
+func bar(completed, total int64) string {
+	var percent float64
+	if total > 0 {
+		percent = float64(completed) * 100 / float64(total)
+	}
+	var filled = int(percent) * barWidth / 100
+	return "[" + strings.Repeat("█", filled) + strings.Repeat("░", barWidth-filled) + "] " + strconv.FormatFloat(percent, 'f', 1, 64) + "%"
+}
+
+func eta(st status) string {
+	if st.speed <= 0 || st.total <= 0 {
+		return "unknown"
+	}
+	return (time.Duration((st.total-st.completed)/st.speed) * time.Second).String()
+}
+
+func formatBytes(size int64) string {
+	const unit = 1024
+	if size < unit {
+		return strconv.FormatInt(size, 10) + " B"
+	}
+	var value, exponent = float64(size) / unit, 0
+	for value >= unit && exponent < 4 {
+		value /= unit
+		exponent++
+	}
+	return strconv.FormatFloat(value, 'f', 1, 64) + " " + string("KMGTP"[exponent]) + "B"
+}
@@ -0,0 +1,113 @@
+package progress
+
+import (
+	"bitbucket.org/y4cxp543/aria2c"
+	"bitbucket.org/y4cxp543/telegram-bot/interfaces"
+	"bitbucket.org/y4cxp543/telegram-bot/telegram/models"
+	"context"
+	"github.com/asaskevich/EventBus"
+	"sync"
+	"testing"
+	"time"
+)
+
+const (
+	addedGid    = "2089b05ecca3d829"
+	followedGid = "d2ad4b1c1f3d9a40"
+)
+
+var start = time.Date(2021, 6, 1, 12, 0, 0, 0, time.UTC)
+
+//fakeClock time moves only by Advance
+type fakeClock struct {
+	now time.Time
+}
+
+func (clock *fakeClock) Now() time.Time {
+	return clock.now
+}
+
+func (clock *fakeClock) After(d time.Duration) <-chan time.Time {
+	return make(chan time.Time)
+}
+
+func (clock *fakeClock) Advance(d time.Duration) {
+	clock.now = clock.now.Add(d)
+}
+
+//fakeFunctions records texts of edited status messages
+type fakeFunctions struct {
+	interfaces.ITelegramFunctions
+	mutex  sync.Mutex
+	edited []string
+}
+
+func (functions *fakeFunctions) EditMessageText(ctx context.Context, request models.EditMessageText) (models.MessageOrTrue, error) {
+	functions.mutex.Lock()
+	defer functions.mutex.Unlock()
+	functions.edited = append(functions.edited, request.Text)
+	return models.MessageOrTrue{}, nil
+}
+
+func (functions *fakeFunctions) last(t *testing.T) string {
+	functions.mutex.Lock()
+	defer functions.mutex.Unlock()
+	if len(functions.edited) == 0 {
+		t.Fatal("Status message is not edited")
+	}
+	return functions.edited[len(functions.edited)-1]
+}
+
+//answer handles tellStatus result of the tracked download as if aria2 answered request id
+func answer(tracker *Tracker, tracked *download, id string, result map[string]interface{}) {
+	tracker.mutex.Lock()
+	tracker.requests[id] = tracked
+	tracker.mutex.Unlock()
+	tracker.StatusReceived(&aria2c.Request{Id: id, Method: aria2c.TellStatus}, &aria2c.Response{Id: id, Result: result})
+}
+
+func TestStatusShowsGid(t *testing.T) {
+	var clock = &fakeClock{now: start}
+	var tracked = &download{ctx: context.Background(), gid: addedGid, addedGid: addedGid, started: start}
+	var text, _ = render(tracked, status{state: statusWaiting}, clock.Now())
+	if text != "Gid: "+addedGid+"\nWaiting in the queue" {
+		t.Errorf("Status without name: %q", text)
+	}
+	tracked.name = "ubuntu.iso"
+	text, _ = render(tracked, status{state: statusWaiting}, clock.Now())
+	if text != "ubuntu.iso\nGid: "+addedGid+"\nWaiting in the queue" {
+		t.Errorf("Status with name: %q", text)
+	}
+}
+
+func TestFollowedDownloadKeepsAddedGid(t *testing.T) {
+	var clock = &fakeClock{now: start}
+	var functions = &fakeFunctions{}
+	var tracker = NewTracker(nil, EventBus.New(), functions, clock, time.Second)
+	var tracked = &download{ctx: context.Background(), gid: addedGid, addedGid: addedGid, chatId: 1, messageId: 2, name: "ubuntu", started: start}
+	tracker.downloads[addedGid] = tracked
+
+	answer(tracker, tracked, "1", map[string]interface{}{
+		"status":     statusComplete,
+		"followedBy": []interface{}{followedGid},
+		"bittorrent": map[string]interface{}{"info": map[string]interface{}{"name": "ubuntu.iso"}},
+	})
+	var expected = "ubuntu.iso\nGid: " + addedGid + ", followed by " + followedGid + "\nWaiting in the queue"
+	if text := functions.last(t); text != expected {
+		t.Errorf("Status after metadata is downloaded: %q, expected %q", text, expected)
+	}
+	if tracker.downloads[followedGid] != tracked {
+		t.Error("Download is not tracked by the following gid")
+	}
+
+	clock.Advance(time.Second)
+	answer(tracker, tracked, "2", map[string]interface{}{
+		"status":          statusComplete,
+		"totalLength":     "1024",
+		"completedLength": "1024",
+	})
+	expected = "ubuntu.iso\nGid: " + addedGid + ", followed by " + followedGid + "\nCompleted at 2021-06-01 12:00:01 in 1s\nSize: 1.0 KB"
+	if text := functions.last(t); text != expected {
+		t.Errorf("Status of completed download: %q, expected %q", text, expected)
+	}
+}
@@ -0,0 +1,330 @@
+package registry
+
+import (
+	"bitbucket.org/y4cxp543/telegram-bot/constants"
+	"bitbucket.org/y4cxp543/telegram-bot/interfaces"
+	"bitbucket.org/y4cxp543/telegram-bot/telegram/command"
+	"bitbucket.org/y4cxp543/telegram-bot/telegram/middleware"
+	"bitbucket.org/y4cxp543/telegram-bot/telegram/models"
+	"context"
+	"encoding/json"
+	"errors"
+	"log"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+)
+
+//menuCommandRegex Telegram shows in the menu only lowercase commands of 1-32 letters, digits and underscores
+var menuCommandRegex = regexp.MustCompile("^[a-z0-9_]{1,32}$")
+
+//Command declares bot command: its arguments, who may run it and where Telegram clients list it
+type Command struct {
+	command.Schema
+	//Descriptions translations of Schema.Description by language code, e.g. "ru"
+	Descriptions map[string]string
+	//Scope where the command is listed in the menu, nil is the default scope
+	Scope models.BotCommandScope
+	//Role required to run the command, empty allows everyone
+	Role constants.Role
+	//Hidden commands work but are listed neither in /help nor in the menu
+	Hidden bool
+	//Middleware wraps only this command, inside the middleware of the registry
+	Middleware []interfaces.IMiddleware
+	Handler    interfaces.IBotCommandFunc
+}
+
+//Description in the language of the user, falls back to the main language subtag and then to Schema.Description
+func (cmd Command) Description(languageCode string) string {
+	if description, ok := cmd.Descriptions[languageCode]; ok {
+		return description
+	}
+	if dash := strings.Index(languageCode, "-"); dash > 0 {
+		if description, ok := cmd.Descriptions[languageCode[:dash]]; ok {
+			return description
+		}
+	}
+	return cmd.Schema.Description
+}
+
+//RoleResolver role of the user who sent the command
+type RoleResolver func(arg interfaces.BotCommandArgument) constants.Role
+
+//MenuRoles role of everyone and roles of the chats known to have more rights, by chat id.
+//Private chat with the user has the id of the user
+type MenuRoles func() (defaultRole constants.Role, chats map[int64]constants.Role)
+
+//Registry dispatches every command only to its own handler and describes the commands for /help and the menu
+type Registry struct {
+	mutex      sync.RWMutex
+	commands   []Command
+	byName     map[string]int
+	roleOf     RoleResolver
+	menuRoles  MenuRoles
+	chain      *middleware.Chain
+	tFunctions interfaces.ITelegramFunctions
+}
+
+//NewRegistry registry with built-in /help, everyone has constants.RoleUser until SetRoleResolver and SetMenuRoles are called
+func NewRegistry(tFunctions interfaces.ITelegramFunctions, chain *middleware.Chain) *Registry {
+	var registry = &Registry{
+		byName:     make(map[string]int),
+		chain:      chain,
+		tFunctions: tFunctions,
+		roleOf: func(interfaces.BotCommandArgument) constants.Role {
+			return constants.RoleUser
+		},
+		menuRoles: func() (constants.Role, map[int64]constants.Role) {
+			return constants.RoleUser, nil
+		},
+	}
+	registry.Register(Command{
+		Schema:       command.Schema{Name: string(constants.Help), Description: "List commands"},
+		Descriptions: map[string]string{"ru": "Список команд"},
+		Handler:      registry.help,
+	})
+	return registry
+}
+
+func (registry *Registry) SetRoleResolver(roleOf RoleResolver) {
+	registry.mutex.Lock()
+	defer registry.mutex.Unlock()
+	registry.roleOf = roleOf
+}
+
+//SetMenuRoles decides which commands are listed in the menu of everyone and which only in the menus of chats with more rights
+func (registry *Registry) SetMenuRoles(menuRoles MenuRoles) {
+	registry.mutex.Lock()
+	defer registry.mutex.Unlock()
+	registry.menuRoles = menuRoles
+}
+
+//Register adds commands, a command with the same name replaces the registered one
+func (registry *Registry) Register(commands ...Command) {
+	registry.mutex.Lock()
+	defer registry.mutex.Unlock()
+	for _, cmd := range commands {
+		var key = strings.ToLower(cmd.Name)
+		if index, ok := registry.byName[key]; ok {
+			registry.commands[index] = cmd
+			continue
+		}
+		registry.byName[key] = len(registry.commands)
+		registry.commands = append(registry.commands, cmd)
+	}
+}
+
+func (registry *Registry) Commands() []Command {
+	registry.mutex.RLock()
+	defer registry.mutex.RUnlock()
+	return append([]Command(nil), registry.commands...)
+}
+
+func (registry *Registry) Schemas() []command.Schema {
+	var schemas []command.Schema
+	for _, cmd := range registry.Commands() {
+		schemas = append(schemas, cmd.Schema)
+	}
+	return schemas
+}
+
+func (registry *Registry) find(name string) (Command, bool) {
+	registry.mutex.RLock()
+	defer registry.mutex.RUnlock()
+	var index, ok = registry.byName[strings.ToLower(name)]
+	if !ok {
+		return Command{}, false
+	}
+	return registry.commands[index], true
+}
+
+func (registry *Registry) role(arg interfaces.BotCommandArgument) constants.Role {
+	registry.mutex.RLock()
+	defer registry.mutex.RUnlock()
+	return registry.roleOf(arg)
+}
+
+//Dispatch calls handler of the command. Returns false if the command is unknown
+func (registry *Registry) Dispatch(arg interfaces.BotCommandArgument) bool {
+	var cmd, ok = registry.find(arg.Command)
+	if !ok {
+		return false
+	}
+	var request = &interfaces.HandlerRequest{
+		Context:   arg.Context,
+		Kind:      interfaces.CommandHandler,
+		Name:      cmd.Name,
+		ChatId:    arg.ChatId,
+		UserId:    arg.UserId,
+		MessageId: arg.MessageId,
+	}
+	var local = append([]interfaces.IMiddleware{registry.requireRole(arg, cmd.Role)}, cmd.Middleware...)
+	var err = registry.chain.Run(request, func(*interfaces.HandlerRequest) error {
+		cmd.Handler(arg)
+		return nil
+	}, local...)
+	switch {
+	case errors.Is(err, middleware.ErrForbidden):
+		registry.reply(arg, "You are not allowed to use /"+cmd.Name)
+	case err != nil:
+		registry.reply(arg, "Something went wrong, /"+cmd.Name+" failed")
+	}
+	return true
+}
+
+func (registry *Registry) requireRole(arg interfaces.BotCommandArgument, required constants.Role) interfaces.IMiddleware {
+	return middleware.Auth(func(*interfaces.HandlerRequest) error {
+		if !registry.role(arg).Allows(required) {
+			return middleware.ErrForbidden
+		}
+		return nil
+	})
+}
+
+//Help lists commands available for the role
+func (registry *Registry) Help(role constants.Role, languageCode string) string {
+	var builder = new(strings.Builder)
+	builder.WriteString("Commands:")
+	for _, cmd := range registry.Commands() {
+		if cmd.Hidden || !role.Allows(cmd.Role) {
+			continue
+		}
+		builder.WriteString("\n" + cmd.Usage() + " - " + cmd.Description(languageCode))
+	}
+	return builder.String()
+}
+
+func (registry *Registry) help(arg interfaces.BotCommandArgument) {
+	var languageCode string
+	if arg.Response != nil && arg.Response.Message != nil && arg.Response.Message.From != nil {
+		languageCode = arg.Response.Message.From.LanguageCode
+	}
+	registry.reply(arg, registry.Help(registry.role(arg), languageCode))
+}
+
+func (registry *Registry) reply(arg interfaces.BotCommandArgument, text string) {
+	if _, err := registry.tFunctions.SendMessage(arg.Context, models.SendMessage{
+		ChatId:           models.NewChatID(arg.ChatId),
+		Text:             text,
+		ReplyToMessageId: models.Int(arg.MessageId),
+	}); err != nil {
+		log.Println(err)
+	}
+}
+
+//menu commands listed in one scope
+type menu struct {
+	scope    models.BotCommandScope
+	commands []Command
+	//chatId menu of one chat, it cannot be set if the chat has never talked to the bot
+	chatId int64
+}
+
+//Sync sets the menu of Telegram clients: one setMyCommands for every scope and every language of descriptions
+func (registry *Registry) Sync(ctx context.Context, api interfaces.IBotAPI) error {
+	var menus = registry.menus()
+	for _, menu := range menus {
+		for _, languageCode := range languages(menu.commands) {
+			var request = models.SetMyCommands{Scope: menu.scope, LanguageCode: languageCode}
+			for _, cmd := range menu.commands {
+				request.Commands = append(request.Commands, models.BotCommand{
+					Command:     strings.ToLower(cmd.Name),
+					Description: cmd.Description(languageCode),
+				})
+			}
+			if _, err := api.SetMyCommands(ctx, request); err != nil {
+				if menu.chatId != 0 {
+					log.Println("Cannot set commands menu of chat ", menu.chatId, ": ", err)
+					break
+				}
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+//menus groups visible commands by scope keeping the registration order. Commands of the default scope
+//the default role may not run are listed only in the menus of chats whose role allows them
+func (registry *Registry) menus() []*menu {
+	registry.mutex.RLock()
+	var menuRoles = registry.menuRoles
+	registry.mutex.RUnlock()
+	var defaultRole, chats = menuRoles()
+	var menus []*menu
+	var byScope = make(map[string]*menu)
+	var restricted bool
+	var listed []Command
+	for _, cmd := range registry.Commands() {
+		if cmd.Hidden {
+			continue
+		}
+		if !menuCommandRegex.MatchString(strings.ToLower(cmd.Name)) {
+			log.Println("Command cannot be listed in the menu: ", cmd.Name)
+			continue
+		}
+		var key, err = json.Marshal(cmd.Scope)
+		if err != nil {
+			log.Println("Wrong scope of command ", cmd.Name, ": ", err)
+			continue
+		}
+		if cmd.Scope == nil {
+			listed = append(listed, cmd)
+			if !defaultRole.Allows(cmd.Role) {
+				restricted = true
+				continue
+			}
+		}
+		var scopeMenu, ok = byScope[string(key)]
+		if !ok {
+			scopeMenu = &menu{scope: cmd.Scope}
+			byScope[string(key)] = scopeMenu
+			menus = append(menus, scopeMenu)
+		}
+		scopeMenu.commands = append(scopeMenu.commands, cmd)
+	}
+	if !restricted {
+		return menus
+	}
+	//Menu of the chat replaces the default one there, so it lists every command of the default scope the role allows
+	for _, chatId := range sortedIds(chats) {
+		var role = chats[chatId]
+		if defaultRole.Allows(role) {
+			continue
+		}
+		var chatMenu = &menu{scope: models.BotCommandScopeChat{ChatId: models.NewChatID(chatId)}, chatId: chatId}
+		for _, cmd := range listed {
+			if role.Allows(cmd.Role) {
+				chatMenu.commands = append(chatMenu.commands, cmd)
+			}
+		}
+		menus = append(menus, chatMenu)
+	}
+	return menus
+}
+
+func sortedIds(chats map[int64]constants.Role) []int64 {
+	var ids = make([]int64, 0, len(chats))
+	for id := range chats {
+		ids = append(ids, id)
+	}
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+	return ids
+}
+
+//languages default language (empty code) and every language the commands are translated to
+func languages(commands []Command) []string {
+	var set = make(map[string]bool)
+	for _, cmd := range commands {
+		for languageCode := range cmd.Descriptions {
+			set[languageCode] = true
+		}
+	}
+	var codes = []string{constants.EmptyString}
+	for languageCode := range set {
+		codes = append(codes, languageCode)
+	}
+	sort.Strings(codes[1:])
+	return codes
+}
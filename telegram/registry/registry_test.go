@@ -0,0 +1,134 @@
+package registry
+
+import (
+	"bitbucket.org/y4cxp543/telegram-bot/constants"
+	"bitbucket.org/y4cxp543/telegram-bot/interfaces"
+	"bitbucket.org/y4cxp543/telegram-bot/telegram/command"
+	"bitbucket.org/y4cxp543/telegram-bot/telegram/middleware"
+	"bitbucket.org/y4cxp543/telegram-bot/telegram/models"
+	"context"
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+const (
+	adminId     int64 = 100
+	userId      int64 = 200
+	allowedChat int64 = -300
+	strangerId  int64 = 400
+)
+
+//fakeApi records menus set by setMyCommands in the default language, by scope. Setting the menu of failingChat fails
+type fakeApi struct {
+	interfaces.IBotAPI
+	failingChat int64
+	menus       map[string]string
+	scopes      []string
+}
+
+func (api *fakeApi) SetMyCommands(ctx context.Context, request models.SetMyCommands) (bool, error) {
+	var scope = "default"
+	if chat, ok := request.Scope.(models.BotCommandScopeChat); ok {
+		if chat.ChatId == models.NewChatID(api.failingChat) {
+			return false, errors.New("Bad Request: chat not found")
+		}
+		scope = "chat " + chat.ChatId.String()
+	}
+	if request.LanguageCode != constants.EmptyString {
+		return true, nil
+	}
+	var names []string
+	for _, cmd := range request.Commands {
+		names = append(names, cmd.Command)
+	}
+	api.menus[scope] = strings.Join(names, " ")
+	api.scopes = append(api.scopes, scope)
+	return true, nil
+}
+
+func noop(interfaces.BotCommandArgument) {}
+
+func newRegistry() *Registry {
+	var registry = NewRegistry(nil, middleware.NewChain())
+	registry.Register(
+		Command{Schema: command.Schema{Name: "join"}, Role: constants.RoleGuest, Handler: noop},
+		Command{Schema: command.Schema{Name: "search"}, Role: constants.RoleUser, Handler: noop},
+		Command{Schema: command.Schema{Name: "invite"}, Role: constants.RoleAdmin, Handler: noop},
+		Command{Schema: command.Schema{Name: "debug"}, Role: constants.RoleAdmin, Hidden: true, Handler: noop},
+	)
+	return registry
+}
+
+func menuRoles(defaultRole constants.Role) MenuRoles {
+	return func() (constants.Role, map[int64]constants.Role) {
+		return defaultRole, map[int64]constants.Role{
+			adminId:     constants.RoleAdmin,
+			userId:      constants.RoleUser,
+			allowedChat: constants.RoleUser,
+			strangerId:  constants.RoleGuest,
+		}
+	}
+}
+
+func TestMenusOfRoles(t *testing.T) {
+	var registry = newRegistry()
+	registry.SetMenuRoles(menuRoles(constants.RoleGuest))
+	var api = &fakeApi{menus: make(map[string]string)}
+	if err := registry.Sync(context.Background(), api); err != nil {
+		t.Fatal(err)
+	}
+	var expected = map[string]string{
+		"default":   "help join",
+		"chat -300": "help join search",
+		"chat 100":  "help join search invite",
+		"chat 200":  "help join search",
+	}
+	if !reflect.DeepEqual(api.menus, expected) {
+		t.Errorf("Menus %v, expected %v", api.menus, expected)
+	}
+}
+
+func TestMenusOfOpenBot(t *testing.T) {
+	var registry = newRegistry()
+	registry.SetMenuRoles(menuRoles(constants.RoleUser))
+	var api = &fakeApi{menus: make(map[string]string)}
+	if err := registry.Sync(context.Background(), api); err != nil {
+		t.Fatal(err)
+	}
+	var expected = map[string]string{
+		"default":  "help join search",
+		"chat 100": "help join search invite",
+	}
+	if !reflect.DeepEqual(api.menus, expected) {
+		t.Errorf("Menus %v, expected %v", api.menus, expected)
+	}
+}
+
+func TestMenuOfUnknownChatIsSkipped(t *testing.T) {
+	var registry = newRegistry()
+	registry.SetMenuRoles(menuRoles(constants.RoleGuest))
+	var api = &fakeApi{menus: make(map[string]string), failingChat: adminId}
+	if err := registry.Sync(context.Background(), api); err != nil {
+		t.Fatal(err)
+	}
+	var expected = []string{"default", "chat -300", "chat 200"}
+	if !reflect.DeepEqual(api.scopes, expected) {
+		t.Errorf("Menus are set for %v, expected %v", api.scopes, expected)
+	}
+}
+
+func TestHelpOfRoles(t *testing.T) {
+	var registry = newRegistry()
+	var tests = map[constants.Role]string{
+		constants.RoleGuest: "Commands:\n/help - List commands\n/join - ",
+		constants.RoleUser:  "Commands:\n/help - List commands\n/join - \n/search - ",
+		constants.RoleAdmin: "Commands:\n/help - List commands\n/join - \n/search - \n/invite - ",
+	}
+	for role, expected := range tests {
+		if help := registry.Help(role, constants.EmptyString); help != expected {
+			t.Errorf("Help of %s: %q, expected %q", role, help, expected)
+		}
+	}
+}
@@ -0,0 +1,60 @@
+package telegram
+
+import (
+	"bitbucket.org/y4cxp543/telegram-bot/interfaces"
+	configuration "bitbucket.org/y4cxp543/telegram-bot/models"
+	"bitbucket.org/y4cxp543/telegram-bot/telegram/models"
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+	"strings"
+	"sync/atomic"
+)
+
+//File limits of Bot API servers. See https://core.telegram.org/bots/api#using-a-local-bot-api-server
+const (
+	publicUploadLimit   int64 = 50 << 20
+	publicDownloadLimit int64 = 20 << 20
+	localUploadLimit    int64 = 2000 << 20
+)
+
+//FileLimits the public server and self-hosted one without --local accept uploads up to 50 MB and give files up to 20 MB,
+//the local one accepts uploads up to 2000 MB and gives files of any size
+func (tFunc *TFunctions) FileLimits() models.FileLimits {
+	if atomic.LoadInt32(&tFunc.localMode) == 1 {
+		return models.FileLimits{Upload: localUploadLimit, Local: true}
+	}
+	return models.FileLimits{Upload: publicUploadLimit, Download: publicDownloadLimit}
+}
+
+//SwitchServer logs the bot out of the server it used before, when the configured server differs.
+//Telegram sends updates only to one server: the public one is left with logOut, a self-hosted one with close.
+//Before the first switch the bot is considered to be on the public server
+func SwitchServer(ctx context.Context, store interfaces.ServerStore, client configuration.Client, httpClient *http.Client) error {
+	var stored, err = store.LastServer()
+	if err != nil || strings.EqualFold(stored, client.ApiServer) {
+		return err
+	}
+	var previous = stored
+	if previous == "" {
+		previous = configuration.PublicApiServer
+	}
+	if !strings.EqualFold(previous, client.ApiServer) {
+		var requestUrl = strings.Replace(previous+"/bot${token}/${method}", "${token}", client.Token, -1)
+		var old = NewTFunctions(requestUrl, "", httpClient, 0, false)
+		var method = "close"
+		if strings.EqualFold(previous, configuration.PublicApiServer) {
+			method = "logOut"
+			_, err = old.LogOut(ctx)
+		} else {
+			_, err = old.Close(ctx)
+		}
+		if err != nil {
+			//Tried again on the next start
+			return fmt.Errorf("cannot %s the bot on Bot API server %s: %w", method, previous, err)
+		}
+		log.Printf("Bot is moved from Bot API server %s to %s", previous, client.ApiServer)
+	}
+	return store.Save(client.ApiServer)
+}
@@ -3,196 +3,414 @@ package telegram
 import (
 	"bitbucket.org/y4cxp543/telegram-bot/cache"
 	"bitbucket.org/y4cxp543/telegram-bot/constants"
-	"bitbucket.org/y4cxp543/telegram-bot/external/torrentz2"
 	"bitbucket.org/y4cxp543/telegram-bot/interfaces"
+	configuration "bitbucket.org/y4cxp543/telegram-bot/models"
 	"bitbucket.org/y4cxp543/telegram-bot/observer"
+	"bitbucket.org/y4cxp543/telegram-bot/telegram/callback"
+	"bitbucket.org/y4cxp543/telegram-bot/telegram/command"
+	"bitbucket.org/y4cxp543/telegram-bot/telegram/conversation"
+	"bitbucket.org/y4cxp543/telegram-bot/telegram/dispatcher"
+	"bitbucket.org/y4cxp543/telegram-bot/telegram/limiter"
+	"bitbucket.org/y4cxp543/telegram-bot/telegram/middleware"
 	"bitbucket.org/y4cxp543/telegram-bot/telegram/models"
-	"bitbucket.org/y4cxp543/telegram-bot/util"
-	"math"
-	"regexp"
-	"strconv"
+	"bitbucket.org/y4cxp543/telegram-bot/telegram/registry"
+	"context"
+	"errors"
+	"log"
+	"net/http"
 	"strings"
+	"sync"
+	"time"
 )
 
+const pollingErrorDelay = 3 * time.Second
+
+//pollingTimeout seconds getUpdates waits for updates
+const pollingTimeout = 30
+const privateChat = "private"
+
 //TelegramBot wrapper for delegating HTTP calls
 type Bot struct {
-	url                 string
-	fileRequestUrl      string
-	cache               interfaces.Cache
-	systemObserver      interfaces.IObserver
-	botCommandsObserver interfaces.IBotCommandObserver
-	tFunctions          interfaces.ITelegramFunctions
-}
-
-func NewBot(url, fileRequest string, iFunc interfaces.ITelegramFunctions) Bot {
-	var telegramBot = Bot{
-		url:                 url,
-		fileRequestUrl:      fileRequest,
-		systemObserver:      new(observer.SystemObserver),
-		botCommandsObserver: new(observer.BotCommandObserver),
-		cache:               new(cache.TemporaryCache),
-		tFunctions:          iFunc,
-	}
-	telegramBot.systemObserver.Register(telegramBot.processPoll, "processPoll", constants.UpdateResponse)
+	url             string
+	fileRequestUrl  string
+	mode            string
+	webhook         configuration.Webhook
+	server          *http.Server
+	offsetStore     interfaces.OffsetStore
+	systemObserver  interfaces.IObserver
+	commandRegistry *registry.Registry
+	callbackRouter  interfaces.ICallbackRouter
+	commandParser   *command.Parser
+	conversations   *conversation.Manager
+	middleware      *middleware.Chain
+	dispatcher      *dispatcher.Dispatcher
+	inlineMutex     sync.RWMutex
+	inlineSearch    interfaces.IInlineSearch
+	statsInterval   time.Duration
+	//handlerCtx is given to handlers, it is cancelled when shutdown runs out of time
+	handlerCtx     context.Context
+	cancelHandlers context.CancelFunc
+	stopOnce       sync.Once
+	tFunctions     interfaces.ITelegramFunctions
+}
+
+func NewBot(client configuration.Client, iFunc interfaces.ITelegramFunctions, offsetStore interfaces.OffsetStore, conversationStore interfaces.ConversationStore) *Bot {
+	var chain = middleware.Default()
+	var telegramBot = &Bot{
+		url:             client.RequestURL,
+		fileRequestUrl:  client.RequestFile,
+		mode:            client.Mode,
+		webhook:         client.Webhook,
+		offsetStore:     offsetStore,
+		systemObserver:  new(observer.SystemObserver),
+		commandRegistry: registry.NewRegistry(iFunc, chain),
+		callbackRouter:  callback.NewRouter(iFunc, chain),
+		commandParser:   command.NewParser(),
+		conversations: conversation.NewManager(new(cache.TemporaryCache), conversationStore, iFunc,
+			limiter.SystemClock{}, time.Duration(client.ConversationTimeout)*time.Second, chain),
+		middleware:    chain,
+		statsInterval: time.Duration(client.Dispatcher.StatsInterval) * time.Second,
+		tFunctions:    iFunc,
+	}
+	telegramBot.handlerCtx, telegramBot.cancelHandlers = context.WithCancel(context.Background())
+	telegramBot.dispatcher = dispatcher.NewDispatcher(client.Dispatcher, telegramBot.handleUpdate, telegramBot.commitOffset)
+	telegramBot.commandRegistry.Register(telegramBot.conversations.CancelCommand())
+	telegramBot.commandParser.Register(telegramBot.commandRegistry.Schemas()...)
+	telegramBot.callbackRouter.Handle(conversation.Prefix, telegramBot.conversations.HandleCallback)
 	telegramBot.systemObserver.Register(telegramBot.processUpdateResponses, "processUpdateResponses", constants.UpdateResponse)
+	telegramBot.systemObserver.Register(telegramBot.processCallbackQueries, "processCallbackQueries", constants.UpdateResponse)
+	telegramBot.systemObserver.Register(telegramBot.processInlineQueries, "processInlineQueries", constants.UpdateResponse)
 	return telegramBot
 }
 
-func parseCommand(text string) (string, string){
-	regex := regexp.MustCompile("^/([a-zA-z]+)")
-	match := regex.FindStringSubmatch(text)
-	fullMatch := match[0]
-	command := match[1]
-	if len(text) < len(fullMatch) {
-		return constants.EmptyString, constants.EmptyString
-	}
-	if len(text) == len(fullMatch) {
-		return command, constants.EmptyString
+//handleUpdate runs on a worker of the dispatcher, updates of one chat come one by one
+func (t *Bot) handleUpdate(update models.Update) {
+	var wrapper = make(map[string]interface{})
+	wrapper[string(constants.Response)] = []models.Update{update}
+	wrapper[string(constants.Context)] = t.handlerCtx
+	t.systemObserver.NotifyAll(constants.UpdateResponse, wrapper)
+}
+
+//submit queues updates for the workers, blocks while the queue is full
+func (t *Bot) submit(updates []models.Update) error {
+	for _, update := range updates {
+		if err := t.dispatcher.Submit(update); err != nil {
+			return err
+		}
 	}
-	arguments := text[len(fullMatch) + 1:]
-	return command, arguments
+	return nil
 }
 
-func (t Bot) processPoll(paramWrapper map[string]interface{}) {
-	if len(paramWrapper) == 1 {
-		var updateResponses = paramWrapper[string(constants.Response)].([]models.Update)
+func (t *Bot) processUpdateResponses(paramWrapper map[string]interface{}) {
+	if updateResponses, ok := paramWrapper[string(constants.Response)].([]models.Update); ok {
+		var ctx = contextOf(paramWrapper)
 		for _, upd := range updateResponses {
-			if upd.PollAnswer != nil {
-				//IF answer is "next page"
-				if upd.PollAnswer.OptionIds[0] == constants.NextPagePollIndex {
-					var cachedVal = t.cache.Get(upd.PollAnswer.PollId)
-					if cachedVal != nil {
-						var results = cachedVal.([]torrentz2.Result)
-						var chatId = paramWrapper[string(constants.ChatId)].(uint64)
-						var messageId = paramWrapper[string(constants.MessageId)].(int)
-						CreatePollFromResults(chatId, messageId, 2, results)
-					}
+			if upd.Message == nil {
+				continue
+			}
+			//Documents and photos carry the command in the caption
+			var text, entities = upd.Message.Text, upd.Message.Entities
+			if len(entities) == 0 {
+				text, entities = upd.Message.Caption, upd.Message.CaptionEntities
+			}
+			var parsed, err = t.commandParser.Parse(text, entities)
+			var userId = userIdOf(upd.Message)
+			if parsed == nil && err == nil {
+				//Not a command, may be an answer in the dialog. Commands for other bots are not answers
+				if command.HasCommand(entities) {
+					continue
 				}
+				t.conversations.Handle(interfaces.ConversationInput{
+					Context:   ctx,
+					ChatId:    upd.Message.Chat.Id,
+					UserId:    userId,
+					MessageId: upd.Message.MessageId,
+					Text:      text,
+					Message:   upd.Message,
+				})
+				continue
+			}
+			//Another command starts over, /cancel reports the dialog itself
+			if parsed != nil && !strings.EqualFold(parsed.Name, conversation.Cancel) {
+				t.conversations.Cancel(upd.Message.Chat.Id, userId)
+			}
+			if err != nil {
+				t.replyUsage(ctx, upd.Message, err)
+				continue
+			}
+			//In groups commands without @botname may be meant for other bots
+			if !t.commandRegistry.Dispatch(t.createBotCommandArgument(ctx, parsed, upd)) && upd.Message.Chat.Type == privateChat {
+				t.replyUsage(ctx, upd.Message, errors.New("Unknown command /"+parsed.Name+", send /"+string(constants.Help)))
 			}
 		}
 	}
 }
 
-
-func CreatePollFromResults(chatId uint64, messageId, pageNumber int, results []torrentz2.Result) models.SendPoll {
-	var pollTextSb = new(strings.Builder)
-	var needAddNextPageOption = true
-	var length = constants.NextPagePollIndex
-	var optionsSize = constants.TelegramMaxPollSize
-	if len(results) <= constants.TelegramMaxPollSize {
-		length = len(results)
-		optionsSize = length
-		needAddNextPageOption = false
-	}
-	if pageNumber != 1 {
-		results = results[constants.NextPagePollIndex * pageNumber:]
-	}
-	var options = make([]string, optionsSize)
-	for index, r := range results {
-		if index == length {
-			break
-		}
-		var resultText = util.AddSpacesBetweenStrings(r.Name, r.Size, r.Age)
-		var totalLength = len(resultText)
-		if totalLength > constants.TelegramMaxPollTextSize {
-			r.Name = r.Name[:len(r.Name)-(totalLength - constants.TelegramMaxPollTextSize) - len(constants.TreeDots)] + constants.TreeDots
-			resultText = util.AddSpacesBetweenStrings(r.Name, r.Size, r.Age)
-		}
-		options[index] = resultText
-	}
-	if needAddNextPageOption {
-		options[constants.NextPagePollIndex] = "Next page"
-		var pageCount = math.Ceil(float64(len(results) / constants.NextPagePollIndex))
-		pollTextSb.WriteString("Page " + strconv.Itoa(pageNumber) + " from " + strconv.Itoa(int(pageCount)))
+//replyUsage tells the user how to call the command or which commands exist
+func (t *Bot) replyUsage(ctx context.Context, message *models.Message, err error) {
+	if _, sendErr := t.tFunctions.SendMessage(ctx, models.SendMessage{
+		ChatId:           models.NewChatID(message.Chat.Id),
+		Text:             err.Error(),
+		ReplyToMessageId: models.Int(message.MessageId),
+	}); sendErr != nil {
+		log.Println(sendErr)
 	}
+}
 
-	return models.SendPoll{
-		ChatId:              chatId,
-		Question:            pollTextSb.String(),
-		Options:             options,
-		IsAnonymous:         false,
-		IsClosed:            false,
-		DisableNotification: false,
-		ReplyToMessageId:    messageId,
+func (t *Bot) processCallbackQueries(paramWrapper map[string]interface{}) {
+	if updateResponses, ok := paramWrapper[string(constants.Response)].([]models.Update); ok {
+		var ctx = contextOf(paramWrapper)
+		for _, upd := range updateResponses {
+			if upd.CallbackQuery != nil {
+				t.callbackRouter.Route(ctx, upd.CallbackQuery)
+			}
+		}
 	}
 }
 
-
-func (t Bot) processUpdateResponses(paramWrapper map[string]interface{}) {
-	if len(paramWrapper) == 1 {
-		var updateResponses = paramWrapper[string(constants.Response)].([]models.Update)
+//processInlineQueries passes inline queries and chosen results through middleware to the inline search
+func (t *Bot) processInlineQueries(paramWrapper map[string]interface{}) {
+	if updateResponses, ok := paramWrapper[string(constants.Response)].([]models.Update); ok {
+		t.inlineMutex.RLock()
+		var search = t.inlineSearch
+		t.inlineMutex.RUnlock()
+		var ctx = contextOf(paramWrapper)
 		for _, upd := range updateResponses {
-			if upd.Message != nil && len(upd.Message.Entities) > 0 {
-				if constants.BotCommand.Equals(upd.Message.Entities[0].Type) {
-					var command, argument = parseCommand(upd.Message.Text)
-					var botCommandArgument = t.createBotCommandArgument(command, argument, upd)
-					t.botCommandsObserver.NotifyAll(botCommandArgument)
+			switch {
+			case upd.InlineQuery != nil:
+				var query = upd.InlineQuery
+				if search == nil {
+					continue
 				}
-			}
-			if upd.Message != nil && len(upd.Message.CaptionEntities) > 0 {
-				if constants.BotCommand.Equals(upd.Message.CaptionEntities[0].Type) {
-					var command, argument = parseCommand(upd.Message.Caption)
-					var botCommandArgument = t.createBotCommandArgument(command, argument, upd)
-					t.botCommandsObserver.NotifyAll(botCommandArgument)
+				var err = t.middleware.Run(inlineRequest(ctx, "query", query.From), func(*interfaces.HandlerRequest) error {
+					return search.HandleQuery(ctx, query)
+				})
+				if errors.Is(err, middleware.ErrForbidden) {
+					//Nothing is shown instead of the progress
+					_, err = t.tFunctions.AnswerInlineQuery(ctx, models.AnswerInlineQuery{
+						InlineQueryId: query.Id,
+						Results:       []models.InlineQueryResult{},
+						CacheTime:     models.Int(0),
+						IsPersonal:    models.Bool(true),
+					})
+				}
+				if err != nil {
+					log.Println(err)
+				}
+			case upd.ChosenInlineResult != nil && search != nil:
+				var chosen = upd.ChosenInlineResult
+				if err := t.middleware.Run(inlineRequest(ctx, "chosen", chosen.From), func(*interfaces.HandlerRequest) error {
+					return search.HandleChosen(ctx, chosen)
+				}); err != nil {
+					log.Println(err)
 				}
 			}
 		}
 	}
 }
 
-func (t *Bot) createBotCommandArgument(command, argument string, upd models.Update) interfaces.BotCommandArgument {
+//inlineRequest inline queries come from any chat, so only the user is known
+func inlineRequest(ctx context.Context, name string, from *models.User) *interfaces.HandlerRequest {
+	var request = &interfaces.HandlerRequest{Context: ctx, Kind: interfaces.InlineHandler, Name: name}
+	if from != nil {
+		request.UserId = from.Id
+	}
+	return request
+}
+
+//contextOf context passed by handleUpdate, observers notified by others get the background one
+func contextOf(paramWrapper map[string]interface{}) context.Context {
+	if ctx, ok := paramWrapper[string(constants.Context)].(context.Context); ok {
+		return ctx
+	}
+	return context.Background()
+}
+
+func (t *Bot) createBotCommandArgument(ctx context.Context, parsed *command.Command, upd models.Update) interfaces.BotCommandArgument {
 	return interfaces.BotCommandArgument{
-		Command:   command,
-		Argument:  argument,
+		Context:   ctx,
+		Command:   parsed.Name,
+		Argument:  parsed.RawArgs,
+		Args:      parsed.Args,
 		MessageId: upd.Message.MessageId,
 		ChatId:    upd.Message.Chat.Id,
+		UserId:    userIdOf(upd.Message),
 		//TFunction: &t.tFunctions,
-		Response:  &upd,
+		Response: &upd,
 		//Cache:	   t.cache,
 	}
 }
 
-func nextOffset(response []models.Update) int {
+//userIdOf sender of the message, channel posts have none
+func userIdOf(message *models.Message) int64 {
+	if message.From == nil {
+		return 0
+	}
+	return message.From.Id
+}
+
+//lastUpdateId returns the greatest update_id of the batch
+func lastUpdateId(response []models.Update) int {
 	answer := 0
-	if response != nil && len(response) > 0 {
-		for _, upd := range response {
-			if answer < upd.UpdateId {
-				answer = upd.UpdateId + 1
-			}
+	for _, upd := range response {
+		if answer < upd.UpdateId {
+			answer = upd.UpdateId
 		}
 	}
 	return answer
 }
 
-func (t Bot) Start() {
-	//TODO: ЧТО за магия????
-	var offset = 908895178
+//commitOffset is called by the dispatcher once every update up to lastUpdateId is handled
+func (t *Bot) commitOffset(lastUpdateId int) {
+	if err := t.offsetStore.Commit(lastUpdateId); err != nil {
+		log.Println("Cannot commit update offset: ", err)
+	}
+}
+
+//Start receives updates using the mode from [Client] configuration until the context is cancelled.
+//Received updates may still be handled, Stop waits for them
+func (t *Bot) Start(ctx context.Context) {
+	t.identify(ctx)
+	t.conversations.Restore()
+	if err := t.commandRegistry.Sync(ctx, t.tFunctions); err != nil {
+		log.Println("Cannot set commands menu: ", err)
+	}
+	go t.logStats(ctx)
+	if constants.Webhook.Equals(t.mode) {
+		if err := t.startWebhook(ctx); err != nil {
+			log.Println("Webhook server stopped: ", err)
+		}
+		return
+	}
+	t.startPolling(ctx)
+}
+
+//identify learns the username, so commands like /search@OtherBot sent to groups are not handled
+func (t *Bot) identify(ctx context.Context) {
+	var me, err = t.tFunctions.GetMe(ctx)
+	if err != nil {
+		log.Println("Cannot get bot username, commands addressed to other bots will be handled: ", err)
+		return
+	}
+	t.commandParser.SetUsername(me.Username)
+}
+
+//Stop stops receiving updates: removes the webhook or ends polling, then waits for the queued and in-flight
+//updates to be handled. Handlers left when the context is done are cancelled
+func (t *Bot) Stop(ctx context.Context) {
+	t.stopOnce.Do(func() {
+		var stopped = make(chan struct{})
+		defer close(stopped)
+		go func() {
+			select {
+			case <-ctx.Done():
+				t.cancelHandlers()
+			case <-stopped:
+			}
+		}()
+		if t.server != nil {
+			t.stopWebhook(ctx)
+		}
+		if err := t.dispatcher.Drain(ctx); err != nil {
+			log.Println("Updates are left unhandled: ", err)
+		}
+		log.Println("Dispatcher stopped: ", t.dispatcher.Stats())
+	})
+}
+
+//Stats metrics of the update queue
+func (t *Bot) Stats() dispatcher.Stats {
+	return t.dispatcher.Stats()
+}
+
+func (t *Bot) logStats(ctx context.Context) {
+	if t.statsInterval <= 0 {
+		return
+	}
+	var ticker = time.NewTicker(t.statsInterval)
+	defer ticker.Stop()
 	for {
-		ch := make(chan []models.Update)
-		go t.tFunctions.GetUpdates(models.GetUpdates{
-			Offset:  offset,
-			Limit:   0,
-			Timeout: 30,
-		}, ch)
-		var response = <-ch
-		offset = nextOffset(response)
-		var wrapper = make(map[string]interface{})
-		wrapper[string(constants.Response)] = response
-		t.systemObserver.NotifyAll(constants.UpdateResponse, wrapper)
-	}
-}
-
-/**
-Each Handler will receive parameters map witch contains keys:
-see BotCommandVariables
-"command"   - Command name
-"argument"  - Command arguments
-"chatId"    - Current chat id
-"messageId" - Current message id
-"response"  - Update Response
-"tFunction" - Telegram API
-"cache" 	- TemporaryCache to store any data
- */
-func (t Bot) RegisterBotCommand(torrents interfaces.IBotCommandFunc, observerId string) {
-	t.botCommandsObserver.Register(torrents, observerId)
-}
\ No newline at end of file
+		select {
+		case <-ticker.C:
+			log.Println("Dispatcher: ", t.dispatcher.Stats())
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+//startPolling long polls getUpdates until the context is cancelled, the pending request is cancelled with it
+func (t *Bot) startPolling(ctx context.Context) {
+	var committed, err = t.offsetStore.LastUpdateId()
+	if err != nil {
+		log.Println("Cannot read update offset, starting from the earliest unconfirmed update: ", err)
+	}
+	var offset = 0
+	if committed > 0 {
+		offset = committed + 1
+	}
+	for ctx.Err() == nil {
+		var response, err = t.tFunctions.GetUpdates(ctx, models.GetUpdates{
+			Offset:  models.Int(offset),
+			Timeout: models.Int(pollingTimeout),
+		})
+		if ctx.Err() != nil {
+			return
+		}
+		if err != nil {
+			log.Println("Cannot get updates: ", err)
+			select {
+			case <-time.After(pollingErrorDelay):
+			case <-ctx.Done():
+			}
+			continue
+		}
+		if len(response) == 0 {
+			continue
+		}
+		//Updates are confirmed by the next offset, but committed to the store only when handled
+		if err := t.submit(response); err != nil {
+			log.Println("Updates are not queued: ", err)
+			return
+		}
+		offset = lastUpdateId(response) + 1
+	}
+}
+
+//RegisterCommands adds commands to the registry, every command is dispatched only to its own handler
+func (t *Bot) RegisterCommands(commands ...registry.Command) {
+	t.commandRegistry.Register(commands...)
+	t.commandParser.Register(t.commandRegistry.Schemas()...)
+}
+
+//RegisterCallback handles pressed inline keyboard buttons with callback_data built by callback.Encode(prefix, ...)
+func (t *Bot) RegisterCallback(prefix string, handler interfaces.ICallbackFunc) {
+	t.callbackRouter.Handle(prefix, handler)
+}
+
+//Conversations lets command handlers ask follow-up questions
+func (t *Bot) Conversations() interfaces.IConversations {
+	return t.conversations
+}
+
+//SetRoleResolver decides which registered commands the sender may run and see in /help
+func (t *Bot) SetRoleResolver(roleOf registry.RoleResolver) {
+	t.commandRegistry.SetRoleResolver(roleOf)
+}
+
+//SetMenuRoles decides which registered commands are listed in the menu of everyone and of chats with more rights
+func (t *Bot) SetMenuRoles(menuRoles registry.MenuRoles) {
+	t.commandRegistry.SetMenuRoles(menuRoles)
+}
+
+//SetInlineSearch answers inline queries, they are ignored until it is set
+func (t *Bot) SetInlineSearch(search interfaces.IInlineSearch) {
+	t.inlineMutex.Lock()
+	defer t.inlineMutex.Unlock()
+	t.inlineSearch = search
+}
+
+//Use adds middleware around every command, callback and dialog message handler
+func (t *Bot) Use(middlewares ...interfaces.IMiddleware) {
+	t.middleware.Use(middlewares...)
+}
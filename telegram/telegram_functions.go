@@ -3,87 +3,138 @@ package telegram
 import (
 	"bitbucket.org/y4cxp543/telegram-bot/constants"
 	"bitbucket.org/y4cxp543/telegram-bot/interfaces"
+	"bitbucket.org/y4cxp543/telegram-bot/telegram/limiter"
 	"bitbucket.org/y4cxp543/telegram-bot/telegram/models"
 	"bitbucket.org/y4cxp543/telegram-bot/util"
+	"context"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"net"
 	"net/http"
+	"path"
+	"path/filepath"
 	"strconv"
 	"strings"
+	"sync/atomic"
+	"time"
 )
 
+//DefaultRequestTimeout limits every api call except uploads, getUpdates gets its long polling timeout on top
+const DefaultRequestTimeout = 60 * time.Second
+
 type TFunctions struct {
 	Url         string
 	FileRequest string
+	RetryPolicy RetryPolicy
+	//RequestTimeout limits api calls and file downloads, uploads are limited only by the context
+	RequestTimeout time.Duration
+	Client         *http.Client
+	//Clock waits between retries
+	Clock      interfaces.Clock
+	migrations chatMigrations
+	//localMode 1 if the server runs with --local, set by configuration or detected by DownloadFile
+	localMode int32
 }
 
-func NewTFunctions(Url string, FileRequest string) interfaces.ITelegramFunctions {
-	return &TFunctions{
-		FileRequest: FileRequest,
-		Url:         Url,
+//NewTFunctions uses NewHTTPClient if client is nil and DefaultRequestTimeout if requestTimeout is not positive.
+//localMode tells the server runs with --local, see FileLimits
+func NewTFunctions(Url string, FileRequest string, client *http.Client, requestTimeout time.Duration, localMode bool) interfaces.ITelegramFunctions {
+	if client == nil {
+		client = NewHTTPClient()
+	}
+	if requestTimeout <= 0 {
+		requestTimeout = DefaultRequestTimeout
+	}
+	var tFunctions = &TFunctions{
+		FileRequest:    FileRequest,
+		Url:            Url,
+		RetryPolicy:    DefaultRetryPolicy,
+		RequestTimeout: requestTimeout,
+		Client:         client,
+		Clock:          limiter.SystemClock{},
 	}
+	if localMode {
+		tFunctions.localMode = 1
+	}
+	return tFunctions
 }
 
-func (tFunc *TFunctions) GetMe() (models.User, error) {
-	url := util.ReplaceMethod(tFunc.Url, constants.Method, constants.GetMe)
-	var answer = models.User{}
-	_ = util.DoGet(url, &answer)
-	return answer, nil
+//NewHTTPClient client with connection timeouts. Whole requests are limited by their context,
+//so long polling and big uploads are not cut off by a client timeout
+func NewHTTPClient() *http.Client {
+	return &http.Client{Transport: &http.Transport{
+		Proxy:                 http.ProxyFromEnvironment,
+		DialContext:           (&net.Dialer{Timeout: 10 * time.Second, KeepAlive: 30 * time.Second}).DialContext,
+		TLSHandshakeTimeout:   10 * time.Second,
+		ExpectContinueTimeout: time.Second,
+		IdleConnTimeout:       90 * time.Second,
+		MaxIdleConnsPerHost:   10,
+	}}
 }
 
-func (tFunc *TFunctions) GetFile(fileId string) (models.File, error) {
-	url := util.ReplaceMethod(tFunc.Url, constants.Method, constants.GetFile)
-	var answer = new(models.File)
-	_ = util.DoPost(url, models.GetFile{
-		FileId: fileId,
-	}, answer)
-	return *answer, nil
+//withTimeout limits the call by RequestTimeout and extra time, e.g. the long polling timeout
+func (tFunc *TFunctions) withTimeout(ctx context.Context, extra time.Duration) (context.Context, context.CancelFunc) {
+	if tFunc.RequestTimeout <= 0 {
+		return context.WithCancel(ctx)
+	}
+	return context.WithTimeout(ctx, tFunc.RequestTimeout+extra)
 }
 
-func (tFunc *TFunctions) DownloadFile(filePath string) []byte {
+//DownloadFile reads file_path returned by getFile. Server in local mode returns absolute path on its disk,
+//the file is read from there, other paths are downloaded from the file endpoint
+func (tFunc *TFunctions) DownloadFile(ctx context.Context, filePath string) ([]byte, error) {
+	if path.IsAbs(filePath) || filepath.IsAbs(filePath) {
+		if atomic.CompareAndSwapInt32(&tFunc.localMode, 0, 1) {
+			log.Println("Bot API server runs in local mode, files are read from disk")
+		}
+		return ioutil.ReadFile(filePath)
+	}
 	var url = util.Replace(tFunc.FileRequest, "filePath", filePath)
-	var response, _ = http.Get(url)
-	var byteArr = util.GetBytes(response)
-	return byteArr
+	ctx, cancel := tFunc.withTimeout(ctx, 0)
+	defer cancel()
+	var request, err = http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, err
+	}
+	response, err := tFunc.Client.Do(request)
+	if err != nil {
+		return nil, err
+	}
+	defer response.Body.Close()
+	if response.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("cannot download file %s, http status %d", filePath, response.StatusCode)
+	}
+	return ioutil.ReadAll(response.Body)
 }
 
-
-func (tFunc *TFunctions) GetUpdates(query models.GetUpdates, response chan []models.Update){
+//GetUpdates waits for updates up to query.Timeout seconds, returns when the context is cancelled
+func (tFunc *TFunctions) GetUpdates(ctx context.Context, query models.GetUpdates) ([]models.Update, error) {
 	url := util.ReplaceMethod(tFunc.Url, constants.Method, constants.GetUpdates)
 	var builder strings.Builder
 	builder.WriteString(url)
 	var isFirst = false
-	if query.Offset != 0 {
-		util.AddQueryParam(&builder, &isFirst, constants.Offset, strconv.Itoa(query.Offset))
+	if query.Offset != nil {
+		util.AddQueryParam(&builder, &isFirst, constants.Offset, strconv.Itoa(*query.Offset))
 	}
-	if query.Timeout != 0 {
-		util.AddQueryParam(&builder, &isFirst, constants.Timeout, strconv.Itoa(query.Timeout))
+	if query.Timeout != nil {
+		util.AddQueryParam(&builder, &isFirst, constants.Timeout, strconv.Itoa(*query.Timeout))
 	}
-	if query.Limit != 0 {
-		util.AddQueryParam(&builder, &isFirst, constants.Limit, strconv.Itoa(query.Limit))
+	if query.Limit != nil {
+		util.AddQueryParam(&builder, &isFirst, constants.Limit, strconv.Itoa(*query.Limit))
 	}
 	if len(query.AllowedUpdates) > 0 {
 		for _, allowedUpdate := range query.AllowedUpdates {
 			util.AddQueryParam(&builder, &isFirst, constants.AllowedUpdates, allowedUpdate)
 		}
 	}
-	var answer []models.Update
-	_ = util.DoGet(builder.String(), &answer)
-	response <- answer
-}
-
-func (tFunc *TFunctions) SendMessage(request models.SendMessage) (models.Message, error) {
-	url := util.ReplaceMethod(tFunc.Url, constants.Method, constants.SendMessage)
-	message := models.Message{}
-	if err := util.DoPost(url, request, &message); err != nil {
-		return message, err
+	var pollTimeout time.Duration
+	if query.Timeout != nil {
+		pollTimeout = time.Duration(*query.Timeout) * time.Second
 	}
-	return message, nil
-}
-
-func (tFunc *TFunctions) SendPoll(poll models.SendPoll) (models.Message, error) {
-	url := util.ReplaceMethod(tFunc.Url, constants.Method, constants.SendPoll)
-	message := models.Message{}
-	if err := util.DoPost(url, poll, &message); err != nil {
-		return message, err
-	}
-	return message, nil
+	ctx, cancel := tFunc.withTimeout(ctx, pollTimeout)
+	defer cancel()
+	var answer []models.Update
+	var err = tFunc.get(ctx, constants.GetUpdates, builder.String(), &answer)
+	return answer, err
 }
@@ -0,0 +1,721 @@
+package telegram
+
+//Generated file
+
+import (
+	"bitbucket.org/y4cxp543/telegram-bot/constants"
+	"bitbucket.org/y4cxp543/telegram-bot/telegram/models"
+	"context"
+	"encoding/json"
+)
+
+//GetMe A simple method for testing your bot's auth token. Requires no parameters. Returns basic information about the bot in form of a User object.
+func (tFunc *TFunctions) GetMe(ctx context.Context) (models.User, error) {
+	var answer models.User
+	if err := tFunc.call(ctx, constants.GetMe, &answer); err != nil {
+		return answer, err
+	}
+	return answer, nil
+}
+
+//LogOut Use this method to log out from the cloud Bot API server before launching the bot locally. You must log out the bot before running it locally, otherwise there is no guarantee that the bot will receive updates. After a successful call, you can immediately log in on a local server, but will not be able to log in back to the cloud Bot API server for 10 minutes. Returns True on success. Requires no parameters.
+func (tFunc *TFunctions) LogOut(ctx context.Context) (bool, error) {
+	var answer bool
+	if err := tFunc.call(ctx, constants.LogOut, &answer); err != nil {
+		return answer, err
+	}
+	return answer, nil
+}
+
+//Close Use this method to close the bot instance before moving it from one local server to another. You need to delete the webhook before calling this method to ensure that the bot isn't launched again after server restart. The method will return error 429 in the first 10 minutes after the bot is launched. Returns True on success. Requires no parameters.
+func (tFunc *TFunctions) Close(ctx context.Context) (bool, error) {
+	var answer bool
+	if err := tFunc.call(ctx, constants.Close, &answer); err != nil {
+		return answer, err
+	}
+	return answer, nil
+}
+
+//SetWebhook Use this method to specify a url and receive incoming updates via an outgoing webhook. Whenever there is an update for the bot, we will send an HTTPS POST request to the specified url, containing a JSON-serialized Update. In case of an unsuccessful request, we will give up after a reasonable amount of attempts. Returns True on success.
+func (tFunc *TFunctions) SetWebhook(ctx context.Context, request models.SetWebhook) (bool, error) {
+	var answer bool
+	if err := tFunc.post(ctx, constants.SetWebhook, &request, &answer); err != nil {
+		return answer, err
+	}
+	return answer, nil
+}
+
+//DeleteWebhook Use this method to remove webhook integration if you decide to switch back to getUpdates. Returns True on success.
+func (tFunc *TFunctions) DeleteWebhook(ctx context.Context, request models.DeleteWebhook) (bool, error) {
+	var answer bool
+	if err := tFunc.post(ctx, constants.DeleteWebhook, &request, &answer); err != nil {
+		return answer, err
+	}
+	return answer, nil
+}
+
+//GetWebhookInfo Use this method to get current webhook status. Requires no parameters. On success, returns a WebhookInfo object. If the bot is using getUpdates, will return an object with the url field empty.
+func (tFunc *TFunctions) GetWebhookInfo(ctx context.Context) (models.WebhookInfo, error) {
+	var answer models.WebhookInfo
+	if err := tFunc.call(ctx, constants.GetWebhookInfo, &answer); err != nil {
+		return answer, err
+	}
+	return answer, nil
+}
+
+//SendMessage Use this method to send text messages. On success, the sent Message is returned.
+func (tFunc *TFunctions) SendMessage(ctx context.Context, request models.SendMessage) (models.Message, error) {
+	var answer models.Message
+	if err := tFunc.post(ctx, constants.SendMessage, &request, &answer); err != nil {
+		return answer, err
+	}
+	return answer, nil
+}
+
+//ForwardMessage Use this method to forward messages of any kind. On success, the sent Message is returned.
+func (tFunc *TFunctions) ForwardMessage(ctx context.Context, request models.ForwardMessage) (models.Message, error) {
+	var answer models.Message
+	if err := tFunc.post(ctx, constants.ForwardMessage, &request, &answer); err != nil {
+		return answer, err
+	}
+	return answer, nil
+}
+
+//SendPhoto Use this method to send photos. On success, the sent Message is returned.
+func (tFunc *TFunctions) SendPhoto(ctx context.Context, request models.SendPhoto) (models.Message, error) {
+	var answer models.Message
+	if err := tFunc.post(ctx, constants.SendPhoto, &request, &answer); err != nil {
+		return answer, err
+	}
+	return answer, nil
+}
+
+//SendAudio Use this method to send audio files, if you want Telegram clients to display them in the music player. Your audio must be in the .MP3 or .M4A format. On success, the sent Message is returned. Bots can currently send audio files of up to 50 MB in size, this limit may be changed in the future.
+func (tFunc *TFunctions) SendAudio(ctx context.Context, request models.SendAudio) (models.Message, error) {
+	var answer models.Message
+	if err := tFunc.post(ctx, constants.SendAudio, &request, &answer); err != nil {
+		return answer, err
+	}
+	return answer, nil
+}
+
+//SendDocument Use this method to send general files. On success, the sent Message is returned. Bots can currently send files of any type of up to 50 MB in size, this limit may be changed in the future.
+func (tFunc *TFunctions) SendDocument(ctx context.Context, request models.SendDocument) (models.Message, error) {
+	var answer models.Message
+	if err := tFunc.post(ctx, constants.SendDocument, &request, &answer); err != nil {
+		return answer, err
+	}
+	return answer, nil
+}
+
+//SendVideo Use this method to send video files, Telegram clients support mp4 videos (other formats may be sent as Document). On success, the sent Message is returned. Bots can currently send video files of up to 50 MB in size, this limit may be changed in the future.
+func (tFunc *TFunctions) SendVideo(ctx context.Context, request models.SendVideo) (models.Message, error) {
+	var answer models.Message
+	if err := tFunc.post(ctx, constants.SendVideo, &request, &answer); err != nil {
+		return answer, err
+	}
+	return answer, nil
+}
+
+//SendAnimation Use this method to send animation files (GIF or H.264/MPEG-4 AVC video without sound). On success, the sent Message is returned. Bots can currently send animation files of up to 50 MB in size, this limit may be changed in the future.
+func (tFunc *TFunctions) SendAnimation(ctx context.Context, request models.SendAnimation) (models.Message, error) {
+	var answer models.Message
+	if err := tFunc.post(ctx, constants.SendAnimation, &request, &answer); err != nil {
+		return answer, err
+	}
+	return answer, nil
+}
+
+//SendVoice Use this method to send audio files, if you want Telegram clients to display the file as a playable voice message. For this to work, your audio must be in an .ogg file encoded with OPUS (other formats may be sent as Audio or Document). On success, the sent Message is returned. Bots can currently send voice messages of up to 50 MB in size, this limit may be changed in the future.
+func (tFunc *TFunctions) SendVoice(ctx context.Context, request models.SendVoice) (models.Message, error) {
+	var answer models.Message
+	if err := tFunc.post(ctx, constants.SendVoice, &request, &answer); err != nil {
+		return answer, err
+	}
+	return answer, nil
+}
+
+//SendVideoNote As of v.4.0, Telegram clients support rounded square mp4 videos of up to 1 minute long. Use this method to send video messages. On success, the sent Message is returned.
+func (tFunc *TFunctions) SendVideoNote(ctx context.Context, request models.SendVideoNote) (models.Message, error) {
+	var answer models.Message
+	if err := tFunc.post(ctx, constants.SendVideoNote, &request, &answer); err != nil {
+		return answer, err
+	}
+	return answer, nil
+}
+
+//SendMediaGroup Use this method to send a group of photos or videos as an album. On success, an array of the sent Messages is returned.
+func (tFunc *TFunctions) SendMediaGroup(ctx context.Context, request models.SendMediaGroup) ([]models.Message, error) {
+	var answer []models.Message
+	if err := tFunc.post(ctx, constants.SendMediaGroup, &request, &answer); err != nil {
+		return answer, err
+	}
+	return answer, nil
+}
+
+//SendLocation Use this method to send point on the map. On success, the sent Message is returned.
+func (tFunc *TFunctions) SendLocation(ctx context.Context, request models.SendLocation) (models.Message, error) {
+	var answer models.Message
+	if err := tFunc.post(ctx, constants.SendLocation, &request, &answer); err != nil {
+		return answer, err
+	}
+	return answer, nil
+}
+
+//EditMessageLiveLocation Use this method to edit live location messages. A location can be edited until its live_period expires or editing is explicitly disabled by a call to stopMessageLiveLocation. On success, if the edited message was sent by the bot, the edited Message is returned, otherwise True is returned.
+func (tFunc *TFunctions) EditMessageLiveLocation(ctx context.Context, request models.EditMessageLiveLocation) (models.MessageOrTrue, error) {
+	var answer models.MessageOrTrue
+	if err := tFunc.post(ctx, constants.EditMessageLiveLocation, &request, &answer); err != nil {
+		return answer, err
+	}
+	return answer, nil
+}
+
+//StopMessageLiveLocation Use this method to stop updating a live location message before live_period expires. On success, if the message was sent by the bot, the sent Message is returned, otherwise True is returned.
+func (tFunc *TFunctions) StopMessageLiveLocation(ctx context.Context, request models.StopMessageLiveLocation) (models.MessageOrTrue, error) {
+	var answer models.MessageOrTrue
+	if err := tFunc.post(ctx, constants.StopMessageLiveLocation, &request, &answer); err != nil {
+		return answer, err
+	}
+	return answer, nil
+}
+
+//SendVenue Use this method to send information about a venue. On success, the sent Message is returned.
+func (tFunc *TFunctions) SendVenue(ctx context.Context, request models.SendVenue) (models.Message, error) {
+	var answer models.Message
+	if err := tFunc.post(ctx, constants.SendVenue, &request, &answer); err != nil {
+		return answer, err
+	}
+	return answer, nil
+}
+
+//SendContact Use this method to send phone contacts. On success, the sent Message is returned.
+func (tFunc *TFunctions) SendContact(ctx context.Context, request models.SendContact) (models.Message, error) {
+	var answer models.Message
+	if err := tFunc.post(ctx, constants.SendContact, &request, &answer); err != nil {
+		return answer, err
+	}
+	return answer, nil
+}
+
+//SendPoll Use this method to send a native poll. On success, the sent Message is returned.
+func (tFunc *TFunctions) SendPoll(ctx context.Context, request models.SendPoll) (models.Message, error) {
+	var answer models.Message
+	if err := tFunc.post(ctx, constants.SendPoll, &request, &answer); err != nil {
+		return answer, err
+	}
+	return answer, nil
+}
+
+//SendChatAction Use this method when you need to tell the user that something is happening on the bot's side. The status is set for 5 seconds or less (when a message arrives from your bot, Telegram clients clear its typing status). Returns True on success.
+func (tFunc *TFunctions) SendChatAction(ctx context.Context, request models.SendChatAction) (bool, error) {
+	var answer bool
+	if err := tFunc.post(ctx, constants.SendChatAction, &request, &answer); err != nil {
+		return answer, err
+	}
+	return answer, nil
+}
+
+//GetUserProfilePhotos Use this method to get a list of profile pictures for a user. Returns a UserProfilePhotos object.
+func (tFunc *TFunctions) GetUserProfilePhotos(ctx context.Context, request models.GetUserProfilePhotos) (models.UserProfilePhotos, error) {
+	var answer models.UserProfilePhotos
+	if err := tFunc.post(ctx, constants.GetUserProfilePhotos, &request, &answer); err != nil {
+		return answer, err
+	}
+	return answer, nil
+}
+
+//GetFile Use this method to get basic info about a file and prepare it for downloading. For the moment, bots can download files of up to 20MB in size. On success, a File object is returned. The file can then be downloaded via the link https://api.telegram.org/file/bot<token>/<file_path>, where <file_path> is taken from the response. It is guaranteed that the link will be valid for at least 1 hour. When the link expires, a new one can be requested by calling getFile again.
+func (tFunc *TFunctions) GetFile(ctx context.Context, request models.GetFile) (models.File, error) {
+	var answer models.File
+	if err := tFunc.post(ctx, constants.GetFile, &request, &answer); err != nil {
+		return answer, err
+	}
+	return answer, nil
+}
+
+//KickChatMember Use this method to kick a user from a group, a supergroup or a channel. In the case of supergroups and channels, the user will not be able to return to the group on their own using invite links, etc., unless unbanned first. The bot must be an administrator in the chat for this to work and must have the appropriate admin rights. Returns True on success.
+func (tFunc *TFunctions) KickChatMember(ctx context.Context, request models.KickChatMember) (bool, error) {
+	var answer bool
+	if err := tFunc.post(ctx, constants.KickChatMember, &request, &answer); err != nil {
+		return answer, err
+	}
+	return answer, nil
+}
+
+//UnbanChatMember Use this method to unban a previously kicked user in a supergroup or channel. The user will not return to the group or channel automatically, but will be able to join via link, etc. The bot must be an administrator for this to work. Returns True on success.
+func (tFunc *TFunctions) UnbanChatMember(ctx context.Context, request models.UnbanChatMember) (bool, error) {
+	var answer bool
+	if err := tFunc.post(ctx, constants.UnbanChatMember, &request, &answer); err != nil {
+		return answer, err
+	}
+	return answer, nil
+}
+
+//RestrictChatMember Use this method to restrict a user in a supergroup. The bot must be an administrator in the supergroup for this to work and must have the appropriate admin rights. Pass True for all permissions to lift restrictions from a user. Returns True on success.
+func (tFunc *TFunctions) RestrictChatMember(ctx context.Context, request models.RestrictChatMember) (bool, error) {
+	var answer bool
+	if err := tFunc.post(ctx, constants.RestrictChatMember, &request, &answer); err != nil {
+		return answer, err
+	}
+	return answer, nil
+}
+
+//PromoteChatMember Use this method to promote or demote a user in a supergroup or a channel. The bot must be an administrator in the chat for this to work and must have the appropriate admin rights. Pass False for all boolean parameters to demote a user. Returns True on success.
+func (tFunc *TFunctions) PromoteChatMember(ctx context.Context, request models.PromoteChatMember) (bool, error) {
+	var answer bool
+	if err := tFunc.post(ctx, constants.PromoteChatMember, &request, &answer); err != nil {
+		return answer, err
+	}
+	return answer, nil
+}
+
+//SetChatAdministratorCustomTitle Use this method to set a custom title for an administrator in a supergroup promoted by the bot. Returns True on success.
+func (tFunc *TFunctions) SetChatAdministratorCustomTitle(ctx context.Context, request models.SetChatAdministratorCustomTitle) (bool, error) {
+	var answer bool
+	if err := tFunc.post(ctx, constants.SetChatAdministratorCustomTitle, &request, &answer); err != nil {
+		return answer, err
+	}
+	return answer, nil
+}
+
+//SetChatPermissions Use this method to set default chat permissions for all members. The bot must be an administrator in the group or a supergroup for this to work and must have the can_restrict_members admin rights. Returns True on success.
+func (tFunc *TFunctions) SetChatPermissions(ctx context.Context, request models.SetChatPermissions) (bool, error) {
+	var answer bool
+	if err := tFunc.post(ctx, constants.SetChatPermissions, &request, &answer); err != nil {
+		return answer, err
+	}
+	return answer, nil
+}
+
+//ExportChatInviteLink Use this method to generate a new invite link for a chat; any previously generated link is revoked. The bot must be an administrator in the chat for this to work and must have the appropriate admin rights. Returns the new invite link as String on success.
+func (tFunc *TFunctions) ExportChatInviteLink(ctx context.Context, request models.ExportChatInviteLink) (string, error) {
+	var answer string
+	if err := tFunc.post(ctx, constants.ExportChatInviteLink, &request, &answer); err != nil {
+		return answer, err
+	}
+	return answer, nil
+}
+
+//SetChatPhoto Use this method to set a new profile photo for the chat. Photos can't be changed for private chats. The bot must be an administrator in the chat for this to work and must have the appropriate admin rights. Returns True on success.
+func (tFunc *TFunctions) SetChatPhoto(ctx context.Context, request models.SetChatPhoto) (bool, error) {
+	var answer bool
+	if err := tFunc.post(ctx, constants.SetChatPhoto, &request, &answer); err != nil {
+		return answer, err
+	}
+	return answer, nil
+}
+
+//DeleteChatPhoto Use this method to delete a chat photo. Photos can't be changed for private chats. The bot must be an administrator in the chat for this to work and must have the appropriate admin rights. Returns True on success.
+func (tFunc *TFunctions) DeleteChatPhoto(ctx context.Context, request models.DeleteChatPhoto) (bool, error) {
+	var answer bool
+	if err := tFunc.post(ctx, constants.DeleteChatPhoto, &request, &answer); err != nil {
+		return answer, err
+	}
+	return answer, nil
+}
+
+//SetChatTitle Use this method to change the title of a chat. Titles can't be changed for private chats. The bot must be an administrator in the chat for this to work and must have the appropriate admin rights. Returns True on success.
+func (tFunc *TFunctions) SetChatTitle(ctx context.Context, request models.SetChatTitle) (bool, error) {
+	var answer bool
+	if err := tFunc.post(ctx, constants.SetChatTitle, &request, &answer); err != nil {
+		return answer, err
+	}
+	return answer, nil
+}
+
+//SetChatDescription Use this method to change the description of a group, a supergroup or a channel. The bot must be an administrator in the chat for this to work and must have the appropriate admin rights. Returns True on success.
+func (tFunc *TFunctions) SetChatDescription(ctx context.Context, request models.SetChatDescription) (bool, error) {
+	var answer bool
+	if err := tFunc.post(ctx, constants.SetChatDescription, &request, &answer); err != nil {
+		return answer, err
+	}
+	return answer, nil
+}
+
+//PinChatMessage Use this method to pin a message in a group, a supergroup, or a channel. The bot must be an administrator in the chat for this to work and must have the ‘can_pin_messages’ admin right in the supergroup or ‘can_edit_messages’ admin right in the channel. Returns True on success.
+func (tFunc *TFunctions) PinChatMessage(ctx context.Context, request models.PinChatMessage) (bool, error) {
+	var answer bool
+	if err := tFunc.post(ctx, constants.PinChatMessage, &request, &answer); err != nil {
+		return answer, err
+	}
+	return answer, nil
+}
+
+//UnpinChatMessage Use this method to unpin a message in a group, a supergroup, or a channel. The bot must be an administrator in the chat for this to work and must have the ‘can_pin_messages’ admin right in the supergroup or ‘can_edit_messages’ admin right in the channel. Returns True on success.
+func (tFunc *TFunctions) UnpinChatMessage(ctx context.Context, request models.UnpinChatMessage) (bool, error) {
+	var answer bool
+	if err := tFunc.post(ctx, constants.UnpinChatMessage, &request, &answer); err != nil {
+		return answer, err
+	}
+	return answer, nil
+}
+
+//LeaveChat Use this method for your bot to leave a group, supergroup or channel. Returns True on success.
+func (tFunc *TFunctions) LeaveChat(ctx context.Context, request models.LeaveChat) (bool, error) {
+	var answer bool
+	if err := tFunc.post(ctx, constants.LeaveChat, &request, &answer); err != nil {
+		return answer, err
+	}
+	return answer, nil
+}
+
+//GetChat Use this method to get up to date information about the chat (current name of the user for one-on-one conversations, current username of a user, group or channel, etc.). Returns a Chat object on success.
+func (tFunc *TFunctions) GetChat(ctx context.Context, request models.GetChat) (models.Chat, error) {
+	var answer models.Chat
+	if err := tFunc.post(ctx, constants.GetChat, &request, &answer); err != nil {
+		return answer, err
+	}
+	return answer, nil
+}
+
+//GetChatAdministrators Use this method to get a list of administrators in a chat. On success, returns an Array of ChatMember objects that contains information about all chat administrators except other bots. If the chat is a group or a supergroup and no administrators were appointed, only the creator will be returned.
+func (tFunc *TFunctions) GetChatAdministrators(ctx context.Context, request models.GetChatAdministrators) ([]models.ChatMember, error) {
+	var answer json.RawMessage
+	if err := tFunc.post(ctx, constants.GetChatAdministrators, &request, &answer); err != nil {
+		return nil, err
+	}
+	return models.UnmarshalChatMemberArray(answer)
+}
+
+//GetChatMembersCount Use this method to get the number of members in a chat. Returns Int on success.
+func (tFunc *TFunctions) GetChatMembersCount(ctx context.Context, request models.GetChatMembersCount) (int, error) {
+	var answer int
+	if err := tFunc.post(ctx, constants.GetChatMembersCount, &request, &answer); err != nil {
+		return answer, err
+	}
+	return answer, nil
+}
+
+//GetChatMember Use this method to get information about a member of a chat. Returns a ChatMember object on success.
+func (tFunc *TFunctions) GetChatMember(ctx context.Context, request models.GetChatMember) (models.ChatMember, error) {
+	var answer json.RawMessage
+	if err := tFunc.post(ctx, constants.GetChatMember, &request, &answer); err != nil {
+		return nil, err
+	}
+	return models.UnmarshalChatMember(answer)
+}
+
+//SetChatStickerSet Use this method to set a new group sticker set for a supergroup. The bot must be an administrator in the chat for this to work and must have the appropriate admin rights. Use the field can_set_sticker_set optionally returned in getChat requests to check if the bot can use this method. Returns True on success.
+func (tFunc *TFunctions) SetChatStickerSet(ctx context.Context, request models.SetChatStickerSet) (bool, error) {
+	var answer bool
+	if err := tFunc.post(ctx, constants.SetChatStickerSet, &request, &answer); err != nil {
+		return answer, err
+	}
+	return answer, nil
+}
+
+//DeleteChatStickerSet Use this method to delete a group sticker set from a supergroup. The bot must be an administrator in the chat for this to work and must have the appropriate admin rights. Use the field can_set_sticker_set optionally returned in getChat requests to check if the bot can use this method. Returns True on success.
+func (tFunc *TFunctions) DeleteChatStickerSet(ctx context.Context, request models.DeleteChatStickerSet) (bool, error) {
+	var answer bool
+	if err := tFunc.post(ctx, constants.DeleteChatStickerSet, &request, &answer); err != nil {
+		return answer, err
+	}
+	return answer, nil
+}
+
+//AnswerCallbackQuery Use this method to send answers to callback queries sent from inline keyboards. The answer will be displayed to the user as a notification at the top of the chat screen or as an alert. On success, True is returned.
+func (tFunc *TFunctions) AnswerCallbackQuery(ctx context.Context, request models.AnswerCallbackQuery) (bool, error) {
+	var answer bool
+	if err := tFunc.post(ctx, constants.AnswerCallbackQuery, &request, &answer); err != nil {
+		return answer, err
+	}
+	return answer, nil
+}
+
+//EditMessageText Use this method to edit text and game messages. On success, if edited message is sent by the bot, the edited Message is returned, otherwise True is returned.
+func (tFunc *TFunctions) EditMessageText(ctx context.Context, request models.EditMessageText) (models.MessageOrTrue, error) {
+	var answer models.MessageOrTrue
+	if err := tFunc.post(ctx, constants.EditMessageText, &request, &answer); err != nil {
+		return answer, err
+	}
+	return answer, nil
+}
+
+//EditMessageCaption Use this method to edit captions of messages. On success, if edited message is sent by the bot, the edited Message is returned, otherwise True is returned.
+func (tFunc *TFunctions) EditMessageCaption(ctx context.Context, request models.EditMessageCaption) (models.MessageOrTrue, error) {
+	var answer models.MessageOrTrue
+	if err := tFunc.post(ctx, constants.EditMessageCaption, &request, &answer); err != nil {
+		return answer, err
+	}
+	return answer, nil
+}
+
+//EditMessageMedia Use this method to edit animation, audio, document, photo, or video messages. If a message is a part of a message album, then it can be edited only to a photo or a video. Otherwise, message type can be changed arbitrarily. When inline message is edited, new file can't be uploaded. Use previously uploaded file via its file_id or specify a URL. On success, if the edited message was sent by the bot, the edited Message is returned, otherwise True is returned.
+func (tFunc *TFunctions) EditMessageMedia(ctx context.Context, request models.EditMessageMedia) (models.MessageOrTrue, error) {
+	var answer models.MessageOrTrue
+	if err := tFunc.post(ctx, constants.EditMessageMedia, &request, &answer); err != nil {
+		return answer, err
+	}
+	return answer, nil
+}
+
+//EditMessageReplyMarkup Use this method to edit only the reply markup of messages. On success, if edited message is sent by the bot, the edited Message is returned, otherwise True is returned.
+func (tFunc *TFunctions) EditMessageReplyMarkup(ctx context.Context, request models.EditMessageReplyMarkup) (models.MessageOrTrue, error) {
+	var answer models.MessageOrTrue
+	if err := tFunc.post(ctx, constants.EditMessageReplyMarkup, &request, &answer); err != nil {
+		return answer, err
+	}
+	return answer, nil
+}
+
+//StopPoll Use this method to stop a poll which was sent by the bot. On success, the stopped Poll with the final results is returned.
+func (tFunc *TFunctions) StopPoll(ctx context.Context, request models.StopPoll) (models.Poll, error) {
+	var answer models.Poll
+	if err := tFunc.post(ctx, constants.StopPoll, &request, &answer); err != nil {
+		return answer, err
+	}
+	return answer, nil
+}
+
+//DeleteMessage Use this method to delete a message, including service messages, with the following limitations:- A message can only be deleted if it was sent less than 48 hours ago.- Bots can delete outgoing messages in private chats, groups, and supergroups.- Bots can delete incoming messages in private chats.- Bots granted can_post_messages permissions can delete outgoing messages in channels.- If the bot is an administrator of a group, it can delete any message there.- If the bot has can_delete_messages permission in a supergroup or a channel, it can delete any message there.Returns True on success.
+func (tFunc *TFunctions) DeleteMessage(ctx context.Context, request models.DeleteMessage) (bool, error) {
+	var answer bool
+	if err := tFunc.post(ctx, constants.DeleteMessage, &request, &answer); err != nil {
+		return answer, err
+	}
+	return answer, nil
+}
+
+//SendSticker Use this method to send static .WEBP or animated .TGS stickers. On success, the sent Message is returned.
+func (tFunc *TFunctions) SendSticker(ctx context.Context, request models.SendSticker) (models.Message, error) {
+	var answer models.Message
+	if err := tFunc.post(ctx, constants.SendSticker, &request, &answer); err != nil {
+		return answer, err
+	}
+	return answer, nil
+}
+
+//GetStickerSet Use this method to get a sticker set. On success, a StickerSet object is returned.
+func (tFunc *TFunctions) GetStickerSet(ctx context.Context, request models.GetStickerSet) (models.StickerSet, error) {
+	var answer models.StickerSet
+	if err := tFunc.post(ctx, constants.GetStickerSet, &request, &answer); err != nil {
+		return answer, err
+	}
+	return answer, nil
+}
+
+//UploadStickerFile Use this method to upload a .png file with a sticker for later use in createNewStickerSet and addStickerToSet methods (can be used multiple times). Returns the uploaded File on success.
+func (tFunc *TFunctions) UploadStickerFile(ctx context.Context, request models.UploadStickerFile) (models.File, error) {
+	var answer models.File
+	if err := tFunc.post(ctx, constants.UploadStickerFile, &request, &answer); err != nil {
+		return answer, err
+	}
+	return answer, nil
+}
+
+//CreateNewStickerSet Use this method to create new sticker set owned by a user. The bot will be able to edit the created sticker set. Returns True on success.
+func (tFunc *TFunctions) CreateNewStickerSet(ctx context.Context, request models.CreateNewStickerSet) (bool, error) {
+	var answer bool
+	if err := tFunc.post(ctx, constants.CreateNewStickerSet, &request, &answer); err != nil {
+		return answer, err
+	}
+	return answer, nil
+}
+
+//AddStickerToSet Use this method to add a new sticker to a set created by the bot. Returns True on success.
+func (tFunc *TFunctions) AddStickerToSet(ctx context.Context, request models.AddStickerToSet) (bool, error) {
+	var answer bool
+	if err := tFunc.post(ctx, constants.AddStickerToSet, &request, &answer); err != nil {
+		return answer, err
+	}
+	return answer, nil
+}
+
+//SetStickerPositionInSet Use this method to move a sticker in a set created by the bot to a specific position . Returns True on success.
+func (tFunc *TFunctions) SetStickerPositionInSet(ctx context.Context, request models.SetStickerPositionInSet) (bool, error) {
+	var answer bool
+	if err := tFunc.post(ctx, constants.SetStickerPositionInSet, &request, &answer); err != nil {
+		return answer, err
+	}
+	return answer, nil
+}
+
+//DeleteStickerFromSet Use this method to delete a sticker from a set created by the bot. Returns True on success.
+func (tFunc *TFunctions) DeleteStickerFromSet(ctx context.Context, request models.DeleteStickerFromSet) (bool, error) {
+	var answer bool
+	if err := tFunc.post(ctx, constants.DeleteStickerFromSet, &request, &answer); err != nil {
+		return answer, err
+	}
+	return answer, nil
+}
+
+//AnswerInlineQuery Use this method to send answers to an inline query. On success, True is returned.No more than 50 results per query are allowed.
+func (tFunc *TFunctions) AnswerInlineQuery(ctx context.Context, request models.AnswerInlineQuery) (bool, error) {
+	var answer bool
+	if err := tFunc.post(ctx, constants.AnswerInlineQuery, &request, &answer); err != nil {
+		return answer, err
+	}
+	return answer, nil
+}
+
+//SendInvoice Use this method to send invoices. On success, the sent Message is returned.
+func (tFunc *TFunctions) SendInvoice(ctx context.Context, request models.SendInvoice) (models.Message, error) {
+	var answer models.Message
+	if err := tFunc.post(ctx, constants.SendInvoice, &request, &answer); err != nil {
+		return answer, err
+	}
+	return answer, nil
+}
+
+//AnswerShippingQuery If you sent an invoice requesting a shipping address and the parameter is_flexible was specified, the Bot API will send an Update with a shipping_query field to the bot. Use this method to reply to shipping queries. On success, True is returned.
+func (tFunc *TFunctions) AnswerShippingQuery(ctx context.Context, request models.AnswerShippingQuery) (bool, error) {
+	var answer bool
+	if err := tFunc.post(ctx, constants.AnswerShippingQuery, &request, &answer); err != nil {
+		return answer, err
+	}
+	return answer, nil
+}
+
+//AnswerPreCheckoutQuery Once the user has confirmed their payment and shipping details, the Bot API sends the final confirmation in the form of an Update with the field pre_checkout_query. Use this method to respond to such pre-checkout queries. On success, True is returned. Note: The Bot API must receive an answer within 10 seconds after the pre-checkout query was sent.
+func (tFunc *TFunctions) AnswerPreCheckoutQuery(ctx context.Context, request models.AnswerPreCheckoutQuery) (bool, error) {
+	var answer bool
+	if err := tFunc.post(ctx, constants.AnswerPreCheckoutQuery, &request, &answer); err != nil {
+		return answer, err
+	}
+	return answer, nil
+}
+
+//SetPassportDataErrors Informs a user that some of the Telegram Passport elements they provided contains errors. The user will not be able to re-submit their Passport to you until the errors are fixed (the contents of the field for which you returned the error must change). Returns True on success.
+func (tFunc *TFunctions) SetPassportDataErrors(ctx context.Context, request models.SetPassportDataErrors) (bool, error) {
+	var answer bool
+	if err := tFunc.post(ctx, constants.SetPassportDataErrors, &request, &answer); err != nil {
+		return answer, err
+	}
+	return answer, nil
+}
+
+//SendGame Use this method to send a game. On success, the sent Message is returned.
+func (tFunc *TFunctions) SendGame(ctx context.Context, request models.SendGame) (models.Message, error) {
+	var answer models.Message
+	if err := tFunc.post(ctx, constants.SendGame, &request, &answer); err != nil {
+		return answer, err
+	}
+	return answer, nil
+}
+
+//SetGameScore Use this method to set the score of the specified user in a game. On success, if the message was sent by the bot, returns the edited Message, otherwise returns True. Returns an error, if the new score is not greater than the user's current score in the chat and force is False.
+func (tFunc *TFunctions) SetGameScore(ctx context.Context, request models.SetGameScore) (models.MessageOrTrue, error) {
+	var answer models.MessageOrTrue
+	if err := tFunc.post(ctx, constants.SetGameScore, &request, &answer); err != nil {
+		return answer, err
+	}
+	return answer, nil
+}
+
+//GetGameHighScores Use this method to get data for high score tables. Will return the score of the specified user and several of his neighbors in a game. On success, returns an Array of GameHighScore objects.
+func (tFunc *TFunctions) GetGameHighScores(ctx context.Context, request models.GetGameHighScores) ([]models.GameHighScore, error) {
+	var answer []models.GameHighScore
+	if err := tFunc.post(ctx, constants.GetGameHighScores, &request, &answer); err != nil {
+		return answer, err
+	}
+	return answer, nil
+}
+
+//CopyMessage Use this method to copy messages of any kind. Service messages and invoice messages can't be copied. The method is analogous to the method forwardMessage, but the copied message doesn't have a link to the original message. Returns the MessageId of the sent message on success.
+func (tFunc *TFunctions) CopyMessage(ctx context.Context, request models.CopyMessage) (models.MessageId, error) {
+	var answer models.MessageId
+	if err := tFunc.post(ctx, constants.CopyMessage, &request, &answer); err != nil {
+		return answer, err
+	}
+	return answer, nil
+}
+
+//SendDice Use this method to send an animated emoji that will display a random value. On success, the sent Message is returned.
+func (tFunc *TFunctions) SendDice(ctx context.Context, request models.SendDice) (models.Message, error) {
+	var answer models.Message
+	if err := tFunc.post(ctx, constants.SendDice, &request, &answer); err != nil {
+		return answer, err
+	}
+	return answer, nil
+}
+
+//BanChatMember Use this method to ban a user in a group, a supergroup or a channel. In the case of supergroups and channels, the user will not be able to return to the chat on their own using invite links, etc., unless unbanned first. The bot must be an administrator in the chat for this to work and must have the appropriate admin rights. Returns True on success.
+func (tFunc *TFunctions) BanChatMember(ctx context.Context, request models.BanChatMember) (bool, error) {
+	var answer bool
+	if err := tFunc.post(ctx, constants.BanChatMember, &request, &answer); err != nil {
+		return answer, err
+	}
+	return answer, nil
+}
+
+//CreateChatInviteLink Use this method to create an additional invite link for a chat. The bot must be an administrator in the chat for this to work and must have the appropriate admin rights. The link can be revoked using the method revokeChatInviteLink. Returns the new invite link as ChatInviteLink object.
+func (tFunc *TFunctions) CreateChatInviteLink(ctx context.Context, request models.CreateChatInviteLink) (models.ChatInviteLink, error) {
+	var answer models.ChatInviteLink
+	if err := tFunc.post(ctx, constants.CreateChatInviteLink, &request, &answer); err != nil {
+		return answer, err
+	}
+	return answer, nil
+}
+
+//EditChatInviteLink Use this method to edit a non-primary invite link created by the bot. The bot must be an administrator in the chat for this to work and must have the appropriate admin rights. Returns the edited invite link as a ChatInviteLink object.
+func (tFunc *TFunctions) EditChatInviteLink(ctx context.Context, request models.EditChatInviteLink) (models.ChatInviteLink, error) {
+	var answer models.ChatInviteLink
+	if err := tFunc.post(ctx, constants.EditChatInviteLink, &request, &answer); err != nil {
+		return answer, err
+	}
+	return answer, nil
+}
+
+//RevokeChatInviteLink Use this method to revoke an invite link created by the bot. If the primary link is revoked, a new link is automatically generated. The bot must be an administrator in the chat for this to work and must have the appropriate admin rights. Returns the revoked invite link as ChatInviteLink object.
+func (tFunc *TFunctions) RevokeChatInviteLink(ctx context.Context, request models.RevokeChatInviteLink) (models.ChatInviteLink, error) {
+	var answer models.ChatInviteLink
+	if err := tFunc.post(ctx, constants.RevokeChatInviteLink, &request, &answer); err != nil {
+		return answer, err
+	}
+	return answer, nil
+}
+
+//UnpinAllChatMessages Use this method to clear the list of pinned messages in a chat. If the chat is not a private chat, the bot must be an administrator in the chat for this to work and must have the 'can_pin_messages' admin right in a supergroup or 'can_edit_messages' admin right in a channel. Returns True on success.
+func (tFunc *TFunctions) UnpinAllChatMessages(ctx context.Context, request models.UnpinAllChatMessages) (bool, error) {
+	var answer bool
+	if err := tFunc.post(ctx, constants.UnpinAllChatMessages, &request, &answer); err != nil {
+		return answer, err
+	}
+	return answer, nil
+}
+
+//GetChatMemberCount Use this method to get the number of members in a chat. Returns Int on success.
+func (tFunc *TFunctions) GetChatMemberCount(ctx context.Context, request models.GetChatMemberCount) (int, error) {
+	var answer int
+	if err := tFunc.post(ctx, constants.GetChatMemberCount, &request, &answer); err != nil {
+		return answer, err
+	}
+	return answer, nil
+}
+
+//SetMyCommands Use this method to change the list of the bot's commands. See https://core.telegram.org/bots#commands for more details about bot commands. Returns True on success.
+func (tFunc *TFunctions) SetMyCommands(ctx context.Context, request models.SetMyCommands) (bool, error) {
+	var answer bool
+	if err := tFunc.post(ctx, constants.SetMyCommands, &request, &answer); err != nil {
+		return answer, err
+	}
+	return answer, nil
+}
+
+//DeleteMyCommands Use this method to delete the list of the bot's commands for the given scope and user language. After deletion, higher level commands will be shown to affected users. Returns True on success.
+func (tFunc *TFunctions) DeleteMyCommands(ctx context.Context, request models.DeleteMyCommands) (bool, error) {
+	var answer bool
+	if err := tFunc.post(ctx, constants.DeleteMyCommands, &request, &answer); err != nil {
+		return answer, err
+	}
+	return answer, nil
+}
+
+//GetMyCommands Use this method to get the current list of the bot's commands for the given scope and user language. Returns Array of BotCommand on success. If commands aren't set, an empty list is returned.
+func (tFunc *TFunctions) GetMyCommands(ctx context.Context, request models.GetMyCommands) ([]models.BotCommand, error) {
+	var answer []models.BotCommand
+	if err := tFunc.post(ctx, constants.GetMyCommands, &request, &answer); err != nil {
+		return answer, err
+	}
+	return answer, nil
+}
+
+//SetStickerSetThumb Use this method to set the thumbnail of a sticker set. Animated thumbnails can be set for animated sticker sets only. Returns True on success.
+func (tFunc *TFunctions) SetStickerSetThumb(ctx context.Context, request models.SetStickerSetThumb) (bool, error) {
+	var answer bool
+	if err := tFunc.post(ctx, constants.SetStickerSetThumb, &request, &answer); err != nil {
+		return answer, err
+	}
+	return answer, nil
+}
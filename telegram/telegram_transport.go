@@ -0,0 +1,249 @@
+package telegram
+
+import (
+	"bitbucket.org/y4cxp543/telegram-bot/constants"
+	"bitbucket.org/y4cxp543/telegram-bot/telegram/models"
+	"bitbucket.org/y4cxp543/telegram-bot/util"
+	"context"
+	"errors"
+	"log"
+	"math/rand"
+	"net"
+	"net/http"
+	"reflect"
+	"strings"
+	"sync"
+	"time"
+)
+
+//RetryPolicy describes how failed api calls are repeated
+type RetryPolicy struct {
+	MaxAttempts int
+	BaseDelay   time.Duration //First backoff delay on 5xx and connection errors, doubled on every attempt
+	MaxDelay    time.Duration
+}
+
+var DefaultRetryPolicy = RetryPolicy{
+	MaxAttempts: 5,
+	BaseDelay:   500 * time.Millisecond,
+	MaxDelay:    30 * time.Second,
+}
+
+//nonIdempotentPrefixes methods which may be already delivered when the server fails or the connection
+//breaks, so repeating them could duplicate messages, invite links, stickers...
+//Other methods (get*, set*, edit*, delete*...) may be repeated safely
+var nonIdempotentPrefixes = []string{"send", "forward", "copy", "create", "export", "upload", "add"}
+
+func isIdempotent(method constants.TelegramMethods) bool {
+	for _, prefix := range nonIdempotentPrefixes {
+		if strings.HasPrefix(method.String(), prefix) {
+			return false
+		}
+	}
+	return true
+}
+
+//chatMigrations remembers groups migrated to supergroups
+type chatMigrations struct {
+	mutex sync.Mutex
+	chats map[int64]int64
+}
+
+func (m *chatMigrations) put(from, to int64) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	if m.chats == nil {
+		m.chats = make(map[int64]int64)
+	}
+	m.chats[from] = to
+}
+
+func (m *chatMigrations) get(from int64) (int64, bool) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	var to, ok = m.chats[from]
+	return to, ok
+}
+
+func (tFunc *TFunctions) post(ctx context.Context, method constants.TelegramMethods, request interface{}, answer interface{}) error {
+	url := util.ReplaceMethod(tFunc.Url, constants.Method, method)
+	if uploads := attachUploads(request); len(uploads) > 0 {
+		return tFunc.retry(ctx, method, request, func() error {
+			return postUploads(ctx, tFunc.Client, url, request, uploads, answer)
+		})
+	}
+	return tFunc.retry(ctx, method, request, func() error {
+		var callCtx, cancel = tFunc.withTimeout(ctx, 0)
+		defer cancel()
+		return util.DoPost(callCtx, tFunc.Client, url, request, answer)
+	})
+}
+
+//get the context limits the whole call including retries, e.g. by the long polling timeout
+func (tFunc *TFunctions) get(ctx context.Context, method constants.TelegramMethods, url string, answer interface{}) error {
+	return tFunc.retry(ctx, method, nil, func() error {
+		return util.DoGet(ctx, tFunc.Client, url, answer)
+	})
+}
+
+//call requests method without parameters
+func (tFunc *TFunctions) call(ctx context.Context, method constants.TelegramMethods, answer interface{}) error {
+	var callCtx, cancel = tFunc.withTimeout(ctx, 0)
+	defer cancel()
+	return tFunc.get(callCtx, method, util.ReplaceMethod(tFunc.Url, constants.Method, method), answer)
+}
+
+//retry repeats call while Telegram asks to wait (retry_after), the chat has been migrated,
+//or the server failed and repeating is safe for the method. Stops when the context is done
+func (tFunc *TFunctions) retry(ctx context.Context, method constants.TelegramMethods, request interface{}, call func() error) error {
+	tFunc.applyMigration(request)
+	var attempt = 0
+	for {
+		var err = call()
+		if err == nil {
+			return nil
+		}
+		if ctx.Err() != nil {
+			return err
+		}
+		attempt++
+		if attempt >= tFunc.RetryPolicy.MaxAttempts {
+			return err
+		}
+		var delay, retryable = tFunc.retryDelay(method, request, err, attempt)
+		if !retryable {
+			return err
+		}
+		log.Printf("%s failed (attempt %d), retrying in %s: %v", method, attempt, delay, err)
+		select {
+		case <-tFunc.Clock.After(delay):
+		case <-ctx.Done():
+			return err
+		}
+	}
+}
+
+func (tFunc *TFunctions) retryDelay(method constants.TelegramMethods, request interface{}, err error, attempt int) (time.Duration, bool) {
+	var notReplayable *notReplayableError
+	if errors.As(err, &notReplayable) {
+		return 0, false
+	}
+	var apiError *models.TelegramAPIError
+	if errors.As(err, &apiError) {
+		switch {
+		case apiError.RetryAfter > 0:
+			//Flood control: the request was rejected, so it is safe to repeat any method
+			return time.Duration(apiError.RetryAfter) * time.Second, true
+		case apiError.MigrateToChatId != 0:
+			return 0, tFunc.migrate(request, apiError.MigrateToChatId)
+		case apiError.ErrorCode >= http.StatusInternalServerError:
+			return tFunc.backoff(attempt), isIdempotent(method)
+		}
+		return 0, false
+	}
+	//The request never left the host, so it is safe to repeat any method
+	if isDialError(err) {
+		return tFunc.backoff(attempt), true
+	}
+	return tFunc.backoff(attempt), isIdempotent(method)
+}
+
+func (tFunc *TFunctions) backoff(attempt int) time.Duration {
+	var delay = tFunc.RetryPolicy.BaseDelay << uint(attempt-1)
+	if delay <= 0 || delay > tFunc.RetryPolicy.MaxDelay {
+		delay = tFunc.RetryPolicy.MaxDelay
+	}
+	//Jitter spreads simultaneous retries of several calls
+	return delay/2 + time.Duration(rand.Int63n(int64(delay/2)+1))
+}
+
+func isDialError(err error) bool {
+	var opError *net.OpError
+	if errors.As(err, &opError) && opError.Op == "dial" {
+		return true
+	}
+	var dnsError *net.DNSError
+	return errors.As(err, &dnsError)
+}
+
+//migrate rewrites chat id of the request and remembers the migration for the next calls
+func (tFunc *TFunctions) migrate(request interface{}, to int64) bool {
+	var field, ok = chatIdField(request)
+	if !ok {
+		return false
+	}
+	var from, set = getChatId(field)
+	if !set || !setChatId(field, to) {
+		return false
+	}
+	log.Printf("Chat %d migrated to %d", from, to)
+	tFunc.migrations.put(from, to)
+	return true
+}
+
+func (tFunc *TFunctions) applyMigration(request interface{}) {
+	var field, ok = chatIdField(request)
+	if !ok {
+		return
+	}
+	if from, set := getChatId(field); set {
+		if to, migrated := tFunc.migrations.get(from); migrated {
+			setChatId(field, to)
+		}
+	}
+}
+
+//chatIdField finds ChatId field of request passed by pointer
+func chatIdField(request interface{}) (reflect.Value, bool) {
+	if request == nil {
+		return reflect.Value{}, false
+	}
+	var value = reflect.ValueOf(request)
+	if value.Kind() != reflect.Ptr || value.Elem().Kind() != reflect.Struct {
+		return reflect.Value{}, false
+	}
+	var field = value.Elem().FieldByName("ChatId")
+	if !field.IsValid() || !field.CanSet() {
+		return reflect.Value{}, false
+	}
+	return field, true
+}
+
+var chatIDType = reflect.TypeOf(models.ChatID{})
+
+func getChatId(field reflect.Value) (int64, bool) {
+	if field.Kind() == reflect.Ptr {
+		if field.IsNil() {
+			return 0, false
+		}
+		field = field.Elem()
+	}
+	if field.Type() == chatIDType {
+		var chatId = field.Interface().(models.ChatID)
+		return chatId.Id, !chatId.IsUsername() && chatId.Id != 0
+	}
+	switch field.Kind() {
+	case reflect.Int, reflect.Int64:
+		return field.Int(), field.Int() != 0
+	}
+	return 0, false
+}
+
+func setChatId(field reflect.Value, chatId int64) bool {
+	if field.Kind() == reflect.Ptr {
+		if field.IsNil() {
+			return false
+		}
+		field = field.Elem()
+	}
+	if field.Type() == chatIDType {
+		field.Set(reflect.ValueOf(models.NewChatID(chatId)))
+		return true
+	}
+	switch field.Kind() {
+	case reflect.Int, reflect.Int64:
+		field.SetInt(chatId)
+		return true
+	}
+	return false
+}
@@ -0,0 +1,129 @@
+package telegram
+
+import (
+	"bitbucket.org/y4cxp543/telegram-bot/telegram/models"
+	"context"
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+)
+
+//fakeClock records retry delays and does not wait
+type fakeClock struct {
+	mutex  sync.Mutex
+	delays []time.Duration
+}
+
+func (clock *fakeClock) Now() time.Time {
+	return time.Now()
+}
+
+func (clock *fakeClock) After(d time.Duration) <-chan time.Time {
+	clock.mutex.Lock()
+	defer clock.mutex.Unlock()
+	clock.delays = append(clock.delays, d)
+	var fired = make(chan time.Time, 1)
+	fired <- time.Now()
+	return fired
+}
+
+//fakeApi answers calls with the queued answers in order, the last answer is repeated
+type fakeApi struct {
+	mutex    sync.Mutex
+	answers  []string
+	requests []map[string]interface{}
+}
+
+func (api *fakeApi) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	var body, _ = ioutil.ReadAll(r.Body)
+	var request map[string]interface{}
+	_ = json.Unmarshal(body, &request)
+	api.mutex.Lock()
+	api.requests = append(api.requests, request)
+	var answer = api.answers[0]
+	if len(api.answers) > 1 {
+		api.answers = api.answers[1:]
+	}
+	api.mutex.Unlock()
+	_, _ = w.Write([]byte(answer))
+}
+
+const sentMessage = `{"ok":true,"result":{"message_id":1,"date":0,"chat":{"id":1,"type":"group"}}}`
+
+func newTestFunctions(t *testing.T, answers ...string) (*TFunctions, *fakeApi, *fakeClock) {
+	var api = &fakeApi{answers: answers}
+	var server = httptest.NewServer(api)
+	t.Cleanup(server.Close)
+	var tFunctions = NewTFunctions(server.URL+"/bot/${method}", "", server.Client(), time.Second, false).(*TFunctions)
+	var clock = new(fakeClock)
+	tFunctions.Clock = clock
+	return tFunctions, api, clock
+}
+
+func TestRetryAfterFloodControl(t *testing.T) {
+	var tFunctions, api, clock = newTestFunctions(t,
+		`{"ok":false,"error_code":429,"description":"Too Many Requests: retry after 3","parameters":{"retry_after":3}}`,
+		sentMessage)
+	if _, err := tFunctions.SendMessage(context.Background(), models.SendMessage{ChatId: models.NewChatID(1), Text: "text"}); err != nil {
+		t.Fatal(err)
+	}
+	if len(api.requests) != 2 {
+		t.Fatalf("sendMessage is sent %d times, expected 2", len(api.requests))
+	}
+	if len(clock.delays) != 1 || clock.delays[0] != 3*time.Second {
+		t.Fatalf("retried after %v, expected 3s", clock.delays)
+	}
+}
+
+func TestChatMigration(t *testing.T) {
+	const group, supergroup = -123456789, -1001234567890
+	var tFunctions, api, clock = newTestFunctions(t,
+		`{"ok":false,"error_code":400,"description":"Bad Request: group chat was upgraded to a supergroup chat","parameters":{"migrate_to_chat_id":-1001234567890}}`,
+		sentMessage)
+	for i := 0; i < 2; i++ {
+		if _, err := tFunctions.SendMessage(context.Background(), models.SendMessage{ChatId: models.NewChatID(group), Text: "text"}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	var chatIds []int64
+	for _, request := range api.requests {
+		var chatId, _ = request["chat_id"].(float64)
+		chatIds = append(chatIds, int64(chatId))
+	}
+	//The second message goes to the supergroup at once
+	if len(chatIds) != 3 || chatIds[0] != group || chatIds[1] != supergroup || chatIds[2] != supergroup {
+		t.Fatalf("messages are sent to chats %v, expected %d, then %d twice", chatIds, group, supergroup)
+	}
+	if len(clock.delays) != 1 || clock.delays[0] != 0 {
+		t.Fatalf("retried after %v, expected at once", clock.delays)
+	}
+}
+
+func TestServerErrorRetriesOnlyIdempotentMethods(t *testing.T) {
+	const serverError = `{"ok":false,"error_code":502,"description":"Bad Gateway"}`
+	var tFunctions, api, clock = newTestFunctions(t, serverError, serverError, `{"ok":true,"result":{"id":1,"is_bot":true,"first_name":"bot"}}`)
+	if _, err := tFunctions.GetMe(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	if len(api.requests) != 3 || len(clock.delays) != 2 {
+		t.Fatalf("getMe is sent %d times with delays %v, expected 3 times", len(api.requests), clock.delays)
+	}
+	if clock.delays[1] < DefaultRetryPolicy.BaseDelay {
+		t.Fatalf("second delay %s is not backed off", clock.delays[1])
+	}
+
+	tFunctions, api, _ = newTestFunctions(t, serverError, sentMessage)
+	var _, err = tFunctions.SendMessage(context.Background(), models.SendMessage{ChatId: models.NewChatID(1), Text: "text"})
+	var apiError *models.TelegramAPIError
+	if !errors.As(err, &apiError) || apiError.ErrorCode != http.StatusBadGateway {
+		t.Fatalf("sendMessage returns %v, expected error 502", err)
+	}
+	if len(api.requests) != 1 {
+		t.Fatalf("sendMessage which may be delivered is sent %d times", len(api.requests))
+	}
+}
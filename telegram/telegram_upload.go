@@ -0,0 +1,167 @@
+package telegram
+
+import (
+	"bitbucket.org/y4cxp543/telegram-bot/telegram/models"
+	"bitbucket.org/y4cxp543/telegram-bot/util"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"mime/multipart"
+	"net/http"
+	"reflect"
+	"strconv"
+)
+
+const attachNamePrefix = "file"
+
+var inputFileType = reflect.TypeOf(models.InputFile{})
+
+//notReplayableError the request uploaded content of io.Reader, which cannot be read again
+type notReplayableError struct {
+	err error
+}
+
+func (e *notReplayableError) Error() string {
+	return "upload cannot be repeated: " + e.err.Error()
+}
+
+func (e *notReplayableError) Unwrap() error {
+	return e.err
+}
+
+//postUploads sends request with uploaded files as multipart/form-data.
+//Every upload is attached under its own name and referenced as attach://<name> from the request fields
+func postUploads(ctx context.Context, client *http.Client, url string, request interface{}, uploads []models.InputFile, answer interface{}) error {
+	var err = postMultipart(ctx, client, url, request, uploads, answer)
+	if err != nil && !replayable(uploads) {
+		return &notReplayableError{err: err}
+	}
+	return err
+}
+
+//attachUploads finds InputFile uploads of the request and names their multipart parts
+func attachUploads(request interface{}) []models.InputFile {
+	var uploads []models.InputFile
+	collectUploads(reflect.ValueOf(request), &uploads)
+	for index, upload := range uploads {
+		upload.Attach(attachNamePrefix + strconv.Itoa(index))
+	}
+	return uploads
+}
+
+func collectUploads(value reflect.Value, uploads *[]models.InputFile) {
+	if !value.IsValid() {
+		return
+	}
+	if value.Type() == inputFileType {
+		var file = value.Interface().(models.InputFile)
+		if file.IsUpload() && !containsUpload(*uploads, file) {
+			*uploads = append(*uploads, file)
+		}
+		return
+	}
+	switch value.Kind() {
+	case reflect.Ptr, reflect.Interface:
+		if !value.IsNil() {
+			collectUploads(value.Elem(), uploads)
+		}
+	case reflect.Struct:
+		for i := 0; i < value.NumField(); i++ {
+			if value.Type().Field(i).PkgPath == "" {
+				collectUploads(value.Field(i), uploads)
+			}
+		}
+	case reflect.Slice, reflect.Array:
+		for i := 0; i < value.Len(); i++ {
+			collectUploads(value.Index(i), uploads)
+		}
+	}
+}
+
+//containsUpload the same upload referenced twice is sent once
+func containsUpload(uploads []models.InputFile, file models.InputFile) bool {
+	for _, upload := range uploads {
+		if upload == file {
+			return true
+		}
+	}
+	return false
+}
+
+func replayable(uploads []models.InputFile) bool {
+	for _, upload := range uploads {
+		if !upload.Replayable() {
+			return false
+		}
+	}
+	return true
+}
+
+func postMultipart(ctx context.Context, client *http.Client, url string, request interface{}, uploads []models.InputFile, answer interface{}) error {
+	var fields, err = formFields(request)
+	if err != nil {
+		return err
+	}
+	log.Printf("Request: %v, files: %d", fields, len(uploads))
+	var reader, writer = io.Pipe()
+	var form = multipart.NewWriter(writer)
+	go func() {
+		writer.CloseWithError(writeForm(form, fields, uploads))
+	}()
+	err = util.DoPostBody(ctx, client, url, form.FormDataContentType(), reader, answer)
+	//Stops writing the form if the request failed before the body was read
+	_ = reader.Close()
+	return err
+}
+
+//formFields encodes top level request fields as form values: strings as is, other values as JSON
+func formFields(request interface{}) (map[string]string, error) {
+	var marshal, err = json.Marshal(request)
+	if err != nil {
+		return nil, err
+	}
+	var raw = make(map[string]json.RawMessage)
+	if err := json.Unmarshal(marshal, &raw); err != nil {
+		return nil, fmt.Errorf("request must be an object: %w", err)
+	}
+	var fields = make(map[string]string, len(raw))
+	for key, value := range raw {
+		var text string
+		if json.Unmarshal(value, &text) == nil {
+			fields[key] = text
+		} else {
+			fields[key] = string(value)
+		}
+	}
+	return fields, nil
+}
+
+func writeForm(form *multipart.Writer, fields map[string]string, uploads []models.InputFile) error {
+	for key, value := range fields {
+		if err := form.WriteField(key, value); err != nil {
+			return err
+		}
+	}
+	for _, upload := range uploads {
+		if err := writeFile(form, upload); err != nil {
+			return err
+		}
+	}
+	return form.Close()
+}
+
+func writeFile(form *multipart.Writer, upload models.InputFile) error {
+	var content, err = upload.Open()
+	if err != nil {
+		return err
+	}
+	defer content.Close()
+	part, err := form.CreateFormFile(upload.AttachName(), upload.FileName())
+	if err != nil {
+		return err
+	}
+	_, err = io.Copy(part, content)
+	return err
+}
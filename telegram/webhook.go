@@ -0,0 +1,98 @@
+package telegram
+
+import (
+	"bitbucket.org/y4cxp543/telegram-bot/constants"
+	"bitbucket.org/y4cxp543/telegram-bot/telegram/models"
+	"context"
+	"crypto/subtle"
+	"encoding/json"
+	"errors"
+	"log"
+	"net/http"
+)
+
+//startWebhook registers the webhook through the API and serves incoming updates until the context is cancelled
+//or the server fails. The server is shut down by Stop
+func (t *Bot) startWebhook(ctx context.Context) error {
+	var request = models.SetWebhook{
+		Url:                t.webhook.Url,
+		DropPendingUpdates: models.Bool(t.webhook.DropPendingUpdates),
+		SecretToken:        t.webhook.SecretToken,
+	}
+	if t.webhook.MaxConnections > 0 {
+		request.MaxConnections = models.Int(t.webhook.MaxConnections)
+	}
+	var _, err = t.tFunctions.SetWebhook(ctx, request)
+	if err != nil {
+		return err
+	}
+
+	var mux = http.NewServeMux()
+	mux.HandleFunc(t.webhook.Path, t.handleWebhook)
+	t.server = &http.Server{
+		Addr:    t.webhook.Listen,
+		Handler: mux,
+	}
+	log.Printf("Listening for webhook updates on %s%s", t.webhook.Listen, t.webhook.Path)
+
+	var served = make(chan error, 1)
+	go func(server *http.Server) {
+		if t.webhook.CertFile != constants.EmptyString && t.webhook.KeyFile != constants.EmptyString {
+			served <- server.ListenAndServeTLS(t.webhook.CertFile, t.webhook.KeyFile)
+		} else {
+			served <- server.ListenAndServe()
+		}
+	}(t.server)
+	select {
+	case err = <-served:
+	case <-ctx.Done():
+		return nil
+	}
+	if errors.Is(err, http.ErrServerClosed) {
+		return nil
+	}
+	return err
+}
+
+//stopWebhook removes the webhook and waits for the requests being served within the context
+func (t *Bot) stopWebhook(ctx context.Context) {
+	if _, err := t.tFunctions.DeleteWebhook(ctx, models.DeleteWebhook{}); err != nil {
+		log.Println("Cannot delete webhook: ", err)
+	}
+	if err := t.server.Shutdown(ctx); err != nil {
+		log.Println("Cannot shutdown webhook server: ", err)
+	}
+}
+
+//handleWebhook pushes a single Update through the same pipeline as long polling does
+func (t *Bot) handleWebhook(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+	if !t.validSecretToken(r.Header.Get(constants.SecretTokenHeader)) {
+		log.Println("Webhook request with wrong secret token from ", r.RemoteAddr)
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+	var update models.Update
+	if err := json.NewDecoder(r.Body).Decode(&update); err != nil {
+		log.Println("Cannot decode webhook update: ", err)
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+	//Telegram waits for the answer before sending the next update, so it is answered once queued
+	if err := t.submit([]models.Update{update}); err != nil {
+		log.Println("Webhook update is not queued: ", err)
+		w.WriteHeader(http.StatusServiceUnavailable)
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+}
+
+func (t *Bot) validSecretToken(token string) bool {
+	if t.webhook.SecretToken == constants.EmptyString {
+		return true
+	}
+	return subtle.ConstantTimeCompare([]byte(token), []byte(t.webhook.SecretToken)) == 1
+}
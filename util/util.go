@@ -5,9 +5,11 @@ import (
 	"bitbucket.org/y4cxp543/telegram-bot/constants"
 	"bitbucket.org/y4cxp543/telegram-bot/telegram/models"
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"github.com/nu7hatch/gouuid"
+	"io"
 	"io/ioutil"
 	"log"
 	"net/http"
@@ -43,6 +45,18 @@ func GetGid(resp *aria2c.Response) string {
 	return gid
 }
 
+//GetAriaError returns JSON representation of aria2 error or empty string
+func GetAriaError(resp *aria2c.Response) string {
+	if resp.Error == nil {
+		return constants.EmptyString
+	}
+	var byteArr, err = json.Marshal(resp.Error)
+	if err != nil {
+		return fmt.Sprint(resp.Error)
+	}
+	return string(byteArr)
+}
+
 func ReplaceMethod(source, condition string, method constants.TelegramMethods) string {
 	return Replace(source, condition, method.String())
 }
@@ -77,40 +91,69 @@ func AddSpacesBetweenStrings(stringArr ...string) string {
 	return builder.String()
 }
 
-func DoPost(url string, request interface{}, object interface{}) error {
+//DoPost sends request as JSON and unmarshal api response result to object.
+//Returns *models.TelegramAPIError if api answered with ok=false
+func DoPost(ctx context.Context, client *http.Client, url string, request interface{}, object interface{}) error {
 	var marshal, err = json.Marshal(request)
 	if err != nil {
-		log.Panic(err)
 		return err
 	}
 	log.Println("Request: " + string(marshal))
-	response, err := http.Post(url, constants.JSONContentType, bytes.NewReader(marshal))
-	apiResponse := models.APIResponse{}
-	_ = UnmarshalToType(response, &apiResponse)
-	result, _ := apiResponse.Result.MarshalJSON()
+	return DoPostBody(ctx, client, url, constants.JSONContentType, bytes.NewReader(marshal), object)
+}
+
+//DoPostBody sends already encoded request body, e.g. multipart/form-data with uploaded files
+func DoPostBody(ctx context.Context, client *http.Client, url, contentType string, body io.Reader, object interface{}) error {
+	request, err := http.NewRequestWithContext(ctx, http.MethodPost, url, body)
+	if err != nil {
+		return err
+	}
+	request.Header.Set("Content-Type", contentType)
+	response, err := client.Do(request)
 	if err != nil {
-		log.Panic(err)
 		return err
 	}
-	//return UnmarshalToType(response, object)
-	return json.Unmarshal(result, &object)
+	return UnmarshalResult(response, object)
 }
 
-func DoGet(url string, object interface{}) error {
-	response, err := http.Get(url)
+//DoGet same as DoPost for requests without body
+func DoGet(ctx context.Context, client *http.Client, url string, object interface{}) error {
+	request, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
 	if err != nil {
-		log.Panic(err)
 		return err
 	}
-	apiResponse := models.APIResponse{}
-	_ = UnmarshalToType(response, &apiResponse)
-	result, _ := apiResponse.Result.MarshalJSON()
-	return json.Unmarshal(result, &object)
+	response, err := client.Do(request)
+	if err != nil {
+		return err
+	}
+	return UnmarshalResult(response, object)
 }
 
+//UnmarshalResult reads api response and unmarshal its result to object
+func UnmarshalResult(response *http.Response, object interface{}) error {
+	apiResponse := models.APIResponse{}
+	if err := UnmarshalToType(response, &apiResponse); err != nil {
+		//Proxies and overloaded servers answer with plain text or html, keep the status for retries
+		return &models.TelegramAPIError{
+			ErrorCode:   response.StatusCode,
+			Description: "cannot read api response: " + err.Error(),
+		}
+	}
+	if !apiResponse.Ok {
+		return models.NewTelegramAPIError(apiResponse)
+	}
+	if err := json.Unmarshal(apiResponse.Result, object); err != nil {
+		return fmt.Errorf("cannot unmarshal api result: %w", err)
+	}
+	return nil
+}
 
 func UnmarshalToType(response *http.Response, object interface{}) error {
-	bytesArr := GetBytes(response)
+	defer response.Body.Close()
+	bytesArr, err := ioutil.ReadAll(response.Body)
+	if err != nil {
+		return err
+	}
 	log.Println("Response: " + string(bytesArr[:]))
 	return json.Unmarshal(bytesArr, object)
 }